@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Address is a postal address for ValidateAddress. Only Country, Subdivision
+// and PostalCode are validated.
+type Address struct {
+	Line1       string
+	Line2       string
+	City        string
+	Subdivision string
+	PostalCode  string
+	Country     string
+}
+
+// AddressOptions configures ValidateAddress.
+type AddressOptions struct {
+	// StopOnFirstFailure validates the components one after another (country,
+	// subdivision, postal code) and stops at the first invalid one to save API
+	// quota, instead of validating them concurrently.
+	StopOnFirstFailure bool
+
+	// Subdivision holds the options for validating the subdivision.
+	Subdivision SubdivisionOptions
+}
+
+// AddressValidationResult holds the results of the components of an address.
+// Components that weren't validated, because they are empty or validation
+// stopped early (see AddressOptions.StopOnFirstFailure), hold the zero result.
+type AddressValidationResult struct {
+	Country     ValidationResult
+	Subdivision ValidationResult
+	PostalCode  ValidationResult
+	// Valid reports whether every validated component is valid.
+	Valid bool
+}
+
+// ValidateAddress validates the country, subdivision and postal code of addr
+// together, like ValidateCountry, ValidateSubdivision and ValidatePostalCode.
+// The country is always validated, the subdivision and postal code only when
+// not empty; the calls run concurrently unless opts.StopOnFirstFailure is set.
+// A malformed country is reported as invalid rather than as an error.
+func (v *Validator) ValidateAddress(ctx context.Context, addr Address, opts AddressOptions) (AddressValidationResult, error) {
+	var result AddressValidationResult
+	country := strings.TrimSpace(addr.Country)
+
+	type component struct {
+		result   *ValidationResult
+		validate func(ctx context.Context) (ValidationResult, error)
+	}
+
+	components := []component{{&result.Country, func(ctx context.Context) (ValidationResult, error) {
+		r, err := v.validateCountry(ctx, country, CountryOptions{})
+		if errors.Is(err, ErrInvalidFormat) {
+			return ValidationResult{Valid: false, Message: "Invalid country code.", Code: country}, nil
+		}
+		return r, err
+	}}}
+	if addr.Subdivision != "" {
+		components = append(components, component{&result.Subdivision, func(ctx context.Context) (ValidationResult, error) {
+			return v.validateSubdivision(ctx, addr.Subdivision, country, opts.Subdivision)
+		}})
+	}
+	if addr.PostalCode != "" {
+		components = append(components, component{&result.PostalCode, func(ctx context.Context) (ValidationResult, error) {
+			return v.ValidatePostalCode(ctx, country, addr.PostalCode)
+		}})
+	}
+
+	if opts.StopOnFirstFailure {
+		for _, c := range components {
+			r, err := c.validate(ctx)
+			*c.result = r
+			if err != nil {
+				return result, err
+			}
+			if !r.Valid {
+				return result, nil
+			}
+		}
+	} else {
+		err := runConcurrent(ctx, len(components), len(components), func(ctx context.Context, i int) error {
+			r, err := components[i].validate(ctx)
+			*components[i].result = r
+			return err
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Valid = true
+	for _, c := range components {
+		result.Valid = result.Valid && c.result.Valid
+	}
+
+	return result, nil
+}
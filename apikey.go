@@ -0,0 +1,133 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultAuthHeader = "Authorization"
+	defaultAuthFormat = "Bearer %s"
+)
+
+type apiKeyContextKey struct{}
+
+// WithAPIKey returns a context whose validation requests use key instead of the
+// Validator's own API key. It only takes effect on validators created with
+// WithContextAPIKey.
+func WithAPIKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, key)
+}
+
+// WithContextAPIKey makes the Validator use the API key stored in each call's
+// context by WithAPIKey, falling back to its own key when there is none. This
+// lets multi-tenant services share one Validator across tenants.
+func WithContextAPIKey() Option {
+	return func(v *Validator) {
+		v.contextAPIKey = true
+	}
+}
+
+// apiKeyFor returns the API key to use for a call.
+func (v *Validator) apiKeyFor(ctx context.Context) string {
+	if v.contextAPIKey {
+		if key, ok := ctx.Value(apiKeyContextKey{}).(string); ok && key != "" {
+			return key
+		}
+	}
+	return v.apiKey
+}
+
+// WithAuthHeader sends the API key in the header name, formatted with format, in
+// which "%s" is replaced by the key, e.g. WithAuthHeader("X-Api-Key", "%s") for a
+// gateway expecting a bare key. The default is WithAuthHeader("Authorization",
+// "Bearer %s"). NewValidator returns an error if name is empty or format doesn't
+// contain "%s" exactly once.
+func WithAuthHeader(name, format string) Option {
+	return func(v *Validator) {
+		v.authHeader = http.CanonicalHeaderKey(name)
+		v.authFormat = format
+	}
+}
+
+// authValue returns the value of the auth header for key.
+func (v *Validator) authValue(key string) string {
+	return strings.Replace(v.authFormat, "%s", key, 1)
+}
+
+// APIKeyMasked returns the Validator's API key with all but its first and last 4
+// characters replaced by "*", or "****" for keys shorter than 8 characters, for
+// safely logging the configuration.
+func (v *Validator) APIKeyMasked() string {
+	if len(v.apiKey) < 8 {
+		return "****"
+	}
+	return v.apiKey[:4] + strings.Repeat("*", len(v.apiKey)-8) + v.apiKey[len(v.apiKey)-4:]
+}
+
+// APIKeyCheck sets how strictly NewValidator checks the shape of the API key.
+type APIKeyCheck int
+
+const (
+	// APIKeyCheckBasic rejects keys that are certainly wrong: placeholders copied
+	// from examples, such as "YOUR_API_KEY", unexpanded variables such as
+	// "${COUNTRIESDB_KEY}", masked keys such as "****", and keys containing
+	// control characters. It is the default.
+	APIKeyCheckBasic APIKeyCheck = iota
+	// APIKeyCheckOff accepts any non-blank key.
+	APIKeyCheckOff
+	// APIKeyCheckStrict additionally rejects keys containing whitespace, quotes
+	// or characters outside printable ASCII, which usually come from a badly
+	// copied or quoted configuration value.
+	APIKeyCheckStrict
+)
+
+// WithAPIKeyCheck sets how strictly NewValidator checks the shape of the API
+// key, so configuration mistakes fail at startup with an error wrapping
+// ErrInvalidAPIKey rather than with a 401 on the first request.
+func WithAPIKeyCheck(check APIKeyCheck) Option {
+	return func(v *Validator) {
+		v.apiKeyCheck = check
+	}
+}
+
+// apiKeyPlaceholders are placeholder keys, lowercased with everything but
+// letters and digits removed.
+var apiKeyPlaceholders = map[string]bool{
+	"apikey": true, "yourapikey": true, "yourkey": true, "yourprivatekey": true,
+	"yourprivateapikey": true, "privatekey": true, "insertapikeyhere": true,
+	"changeme": true, "placeholder": true, "replaceme": true,
+}
+
+// checkAPIKey reports why key is certainly not a valid API key at the given
+// check level, without including the key itself.
+func checkAPIKey(key string, check APIKeyCheck) error {
+	if check == APIKeyCheckOff {
+		return nil
+	}
+
+	var letters strings.Builder
+	for _, r := range key {
+		switch {
+		case r < ' ' || r == 0x7f:
+			return fmt.Errorf("%w: contains control characters", ErrInvalidAPIKey)
+		case check == APIKeyCheckStrict && (r > '~' || r == ' ' || r == '"' || r == '\''):
+			return fmt.Errorf("%w: contains whitespace, quotes or non-ASCII characters", ErrInvalidAPIKey)
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+			letters.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	trimmed := strings.TrimSpace(key)
+	switch {
+	case apiKeyPlaceholders[letters.String()]:
+		return fmt.Errorf("%w: looks like a placeholder from an example", ErrInvalidAPIKey)
+	case strings.HasPrefix(trimmed, "${") || strings.HasPrefix(trimmed, "$(") || strings.Trim(letters.String(), "x") == "":
+		return fmt.Errorf("%w: looks like an unexpanded variable or a masked key", ErrInvalidAPIKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package validator
+
+import "testing"
+
+func TestAPIKeyMasked(t *testing.T) {
+	for _, tc := range []struct {
+		key, want string
+	}{
+		{"", "****"},
+		{"abc", "****"},
+		{"abcdefg", "****"},
+		{"abcdefgh", "abcdefgh"},
+		{"abcdefghi", "abcd*fghi"},
+		{"abcdefghijkl", "abcd****ijkl"},
+		{"sk_live_0123456789abcdef", "sk_l****************cdef"},
+	} {
+		v := &Validator{apiKey: tc.key}
+		if got := v.APIKeyMasked(); got != tc.want {
+			t.Errorf("APIKeyMasked() of %q = %q, want %q", tc.key, got, tc.want)
+		}
+	}
+}
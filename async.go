@@ -0,0 +1,25 @@
+package validator
+
+import "context"
+
+// CountryResult bundles the outcome of an asynchronous country validation.
+type CountryResult struct {
+	Result ValidationResult
+	Err    error
+}
+
+// ValidateCountryAsync runs ValidateCountry in a new goroutine and returns a
+// channel that receives its outcome and is then closed. The channel is buffered,
+// so the goroutine never blocks if the result isn't read; cancel ctx to abort the
+// call.
+func (v *Validator) ValidateCountryAsync(ctx context.Context, code string, opts CountryOptions) <-chan CountryResult {
+	ch := make(chan CountryResult, 1)
+
+	go func() {
+		defer close(ch)
+		result, err := v.ValidateCountry(ctx, code, opts)
+		ch <- CountryResult{Result: result, Err: err}
+	}()
+
+	return ch
+}
@@ -0,0 +1,164 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditEntry records one code validated by the API.
+type AuditEntry struct {
+	// Time is when the request for the code was started.
+	Time time.Time `json:"time"`
+	// Path is the API endpoint, e.g. "/api/validate/country".
+	Path string `json:"path"`
+	// Code is the value sent for validation: a country or subdivision code,
+	// timezone, TLD, postal code, VAT number, payment method, country name or
+	// carrier depending on Path.
+	Code string `json:"code"`
+	// Country is the country the code was validated against, if any.
+	Country string `json:"country,omitempty"`
+	// Result is the API's verdict; it is the zero value when Error is set. For
+	// lookups that don't return a ValidationResult, such as ParentSubdivision
+	// and ChildSubdivisions, a successful call is recorded as valid.
+	Result ValidationResult `json:"result"`
+	// Latency is the time from the start of the request until the result was
+	// decoded, in nanoseconds when encoded as JSON.
+	Latency time.Duration `json:"latency"`
+	// Error is the error the call failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink records audit entries, e.g. to durable storage.
+type AuditSink interface {
+	Record(entry AuditEntry) error
+}
+
+// WithAuditLog records an AuditEntry in sink for every code sent to the API,
+// including codes of calls that fail and codes answered from the cache (see
+// WithCache). Codes rejected locally before any request is made (e.g. malformed
+// ones) are not recorded. If sink returns an error, the call returns it, so that no
+// validation goes unrecorded.
+//
+// Unlike WithDebug and WithRequestLogging it is meant for compliance
+// record-keeping in production. Record may be called concurrently.
+func WithAuditLog(sink AuditSink) Option {
+	return func(v *Validator) {
+		v.audit = sink
+	}
+}
+
+// FileAuditSink is an AuditSink that writes each entry as one line of JSON
+// (NDJSON). It is safe for concurrent use.
+type FileAuditSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFileAuditSink returns a FileAuditSink writing to w, e.g. an append-only file.
+func NewFileAuditSink(w io.Writer) *FileAuditSink {
+	return &FileAuditSink{w: w}
+}
+
+// Record writes entry as one line of JSON.
+func (s *FileAuditSink) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// auditCodeKeys are the payload keys holding the validated value, by endpoint.
+var auditCodeKeys = []string{"code", "timezone", "tld", "postal_code", "vat", "method", "name", "carrier"}
+
+// startAudit prepares the audit of one post call. It returns the out to decode
+// into, wrapping a resultSink so that each batch result is recorded as it is
+// decoded, and a finish function that records what remains once the call has
+// returned err and returns the error the call should report.
+func (v *Validator) startAudit(path string, payload map[string]any, out any) (any, func(err error) error) {
+	start := time.Now()
+	country, _ := payload["country"].(string)
+
+	var codes []string
+	for _, key := range auditCodeKeys {
+		if code, ok := payload[key].(string); ok {
+			codes = []string{code}
+			break
+		}
+		if batch, ok := payload[key].([]string); ok {
+			codes = batch
+			break
+		}
+	}
+
+	recorded := 0
+	var recordErr error
+	record := func(result ValidationResult, callErr error) error {
+		entry := AuditEntry{
+			Time:    start,
+			Path:    path,
+			Code:    codes[recorded],
+			Country: country,
+			Result:  result,
+			Latency: time.Since(start),
+		}
+		if callErr != nil {
+			entry.Error = callErr.Error()
+		}
+		recorded++
+
+		if err := v.audit.Record(entry); err != nil {
+			recordErr = fmt.Errorf("countriesdb: recording audit entry: %w", err)
+			return recordErr
+		}
+		return nil
+	}
+
+	sink, streamed := out.(resultSink)
+	if streamed {
+		out = resultSink(func(result ValidationResult) error {
+			if recorded < len(codes) {
+				if err := record(result, nil); err != nil {
+					return err
+				}
+			}
+			return sink(result)
+		})
+	}
+
+	finish := func(err error) error {
+		if recordErr != nil {
+			return recordErr
+		}
+
+		if err == nil && !streamed && recorded < len(codes) {
+			// Lookups that don't decode into a ValidationResult, such as
+			// ParentSubdivision, only succeed for codes that exist.
+			result := ValidationResult{Valid: true, Code: codes[recorded]}
+			if r, ok := out.(*ValidationResult); ok {
+				result = *r
+			}
+			if rerr := record(result, nil); rerr != nil {
+				return rerr
+			}
+		}
+
+		for err != nil && recorded < len(codes) {
+			if rerr := record(ValidationResult{}, err); rerr != nil {
+				return rerr
+			}
+		}
+
+		return err
+	}
+
+	return out, finish
+}
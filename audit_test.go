@@ -0,0 +1,64 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type memoryAuditSink struct {
+	mu      sync.Mutex
+	entries []AuditEntry
+}
+
+func (s *memoryAuditSink) Record(entry AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestAuditLogRecordsHierarchyLookups(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/subdivision/parent":
+			w.Write([]byte(`{"code":"FR-IDF","name":"Île-de-France"}`))
+		case "/api/subdivision/children":
+			w.Write([]byte(`{"results":[{"code":"FR-75","name":"Paris"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	sink := &memoryAuditSink{}
+	v, err := NewValidator("test-api-key", WithBaseURL(srv.URL), WithAuditLog(sink))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := v.ParentSubdivision(ctx, "FR-75", "FR"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.ChildSubdivisions(ctx, "fr-idf", "fr"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []AuditEntry{
+		{Path: "/api/subdivision/parent", Code: "FR-75", Country: "FR", Result: ValidationResult{Valid: true, Code: "FR-75"}},
+		{Path: "/api/subdivision/children", Code: "FR-IDF", Country: "FR", Result: ValidationResult{Valid: true, Code: "FR-IDF"}},
+	}
+	if len(sink.entries) != len(want) {
+		t.Fatalf("recorded %d entries, want %d: %+v", len(sink.entries), len(want), sink.entries)
+	}
+	for i, got := range sink.entries {
+		if got.Path != want[i].Path || got.Code != want[i].Code || got.Country != want[i].Country ||
+			!reflect.DeepEqual(got.Result, want[i].Result) || got.Error != "" || got.Latency <= 0 {
+			t.Errorf("entry %d = %+v, want %+v with a latency", i, got, want[i])
+		}
+	}
+}
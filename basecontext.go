@@ -0,0 +1,29 @@
+package validator
+
+import "context"
+
+// WithBaseContext ties every request of the Validator to ctx: cancelling ctx
+// aborts in-flight requests (including retries and backoff waits) and makes new
+// ones fail immediately with context.Canceled, e.g. to drain cleanly on shutdown.
+// Each call's own context still applies as well. Cached responses (see WithCache)
+// are still served after ctx is cancelled.
+func WithBaseContext(ctx context.Context) Option {
+	return func(v *Validator) {
+		v.baseCtx = ctx
+	}
+}
+
+// withBaseContext returns ctx, additionally cancelled when the base context set
+// by WithBaseContext is done. The returned stop function releases its resources.
+func (v *Validator) withBaseContext(ctx context.Context) (context.Context, func()) {
+	if v.baseCtx == nil {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(v.baseCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
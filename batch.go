@@ -0,0 +1,232 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CountryRequest is one code with its own options for ValidateCountriesBatch.
+type CountryRequest struct {
+	Code    string
+	Options CountryOptions
+}
+
+// ValidateCountriesBatch validates country codes that each carry their own options
+// and returns the results in input order. Codes without FollowUpward share one
+// multi-select request; the API only follows upward for single codes, so every
+// code with FollowUpward is sent on its own. Requests run concurrently up to the
+// limit set by WithConcurrency.
+func (v *Validator) ValidateCountriesBatch(ctx context.Context, requests []CountryRequest) ([]ValidationResult, error) {
+	results := make([]ValidationResult, len(requests))
+	if len(requests) == 0 {
+		return results, nil
+	}
+	ctx = v.withProgress(ctx, len(requests))
+
+	var shared, single []int
+	for i, req := range requests {
+		if req.Options.FollowUpward {
+			single = append(single, i)
+		} else {
+			shared = append(shared, i)
+		}
+	}
+
+	jobs := make([]func(ctx context.Context) error, 0, len(single)+1)
+
+	if len(shared) > 0 {
+		jobs = append(jobs, func(ctx context.Context) error {
+			codes := make([]string, len(shared))
+			for j, i := range shared {
+				codes[j] = requests[i].Code
+			}
+
+			batch, err := v.validateCountriesChunked(ctx, codes, CountryOptions{})
+			if err != nil {
+				return err
+			}
+			if len(batch) != len(codes) {
+				return fmt.Errorf("countriesdb: got %d results for %d codes", len(batch), len(codes))
+			}
+
+			for j, i := range shared {
+				results[i] = requireContinent(batch[j], requests[i].Options)
+			}
+			return nil
+		})
+	}
+
+	for _, i := range single {
+		jobs = append(jobs, func(ctx context.Context) error {
+			result, err := v.validateCountry(ctx, requests[i].Code, requests[i].Options)
+			if errors.Is(err, ErrInvalidFormat) {
+				result, err = ValidationResult{Valid: false, Message: "Invalid country code.", Code: requests[i].Code}, nil
+			}
+			results[i] = result
+			if err == nil {
+				reportProgress(ctx, 1)
+			}
+			return err
+		})
+	}
+
+	err := runConcurrent(ctx, v.concurrency, len(jobs), func(ctx context.Context, j int) error {
+		return jobs[j](ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, len(requests))
+	for i, req := range requests {
+		codes[i] = req.Code
+	}
+
+	return results, v.invalidErrors(codes, results)
+}
+
+// SubdivisionRef is a subdivision code together with its country.
+type SubdivisionRef struct {
+	Code    string
+	Country string
+}
+
+// ValidateSubdivisionPairs validates subdivisions that belong to different
+// countries. Refs are grouped by country into one ValidateSubdivisions call per
+// country, run concurrently up to the limit set by WithConcurrency, and the
+// results are returned in input order.
+func (v *Validator) ValidateSubdivisionPairs(ctx context.Context, pairs []SubdivisionRef, opts SubdivisionOptions) ([]ValidationResult, error) {
+	results := make([]ValidationResult, len(pairs))
+	if len(pairs) == 0 {
+		return results, nil
+	}
+	ctx = v.withProgress(ctx, len(pairs))
+
+	groups := make(map[string][]int)
+	var countries []string
+	for i, pair := range pairs {
+		country, ok := countryParam(pair.Country)
+		if !ok {
+			results[i] = ValidationResult{Valid: false, Message: "Invalid country code.", Code: pair.Code}
+			reportProgress(ctx, 1)
+			continue
+		}
+		if _, ok := groups[country]; !ok {
+			countries = append(countries, country)
+		}
+		groups[country] = append(groups[country], i)
+	}
+
+	err := runConcurrent(ctx, v.concurrency, len(countries), func(ctx context.Context, j int) error {
+		indexes := groups[countries[j]]
+		codes := make([]string, len(indexes))
+		for k, i := range indexes {
+			codes[k] = pairs[i].Code
+		}
+
+		batch, err := v.validateSubdivisions(ctx, codes, countries[j], opts)
+		if err != nil {
+			return err
+		}
+		if len(batch) != len(codes) {
+			return fmt.Errorf("countriesdb: got %d results for %d codes", len(batch), len(codes))
+		}
+
+		for k, i := range indexes {
+			results[i] = batch[k]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, len(pairs))
+	for i, pair := range pairs {
+		codes[i] = pair.Code
+	}
+
+	return results, v.invalidErrors(codes, results)
+}
+
+// CountryExistenceMap reports whether each country code exists, keyed by its
+// normalized (trimmed, uppercased) form, so duplicate inputs collapse to a single
+// entry. Codes that aren't ASCII are reported as not existing without being sent.
+// The distinct codes are sent in chunks of the configured batch size (see
+// WithBatchSize), run concurrently up to the limit set by WithConcurrency.
+func (v *Validator) CountryExistenceMap(ctx context.Context, codes []string) (map[string]bool, error) {
+	exists := make(map[string]bool, len(codes))
+
+	var distinct []string
+	for _, code := range codes {
+		normalized, ok := asciiUpper(strings.TrimSpace(code))
+		if !ok {
+			exists[strings.TrimSpace(code)] = false
+			continue
+		}
+		if _, seen := exists[normalized]; !seen {
+			exists[normalized] = false
+			distinct = append(distinct, normalized)
+		}
+	}
+
+	results, err := v.validateCountriesChunked(v.withProgress(ctx, len(distinct)), distinct, CountryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range results {
+		exists[distinct[i]] = result.Valid
+	}
+
+	return exists, nil
+}
+
+// ValidateCountrySet validates every code in set and returns the results keyed
+// by code, e.g. results["US"].Valid. The codes are sent in chunks of the
+// configured batch size (see WithBatchSize), run concurrently up to the limit
+// set by WithConcurrency.
+func (v *Validator) ValidateCountrySet(ctx context.Context, set CountryCodeSet, opts CountryOptions) (map[string]ValidationResult, error) {
+	codes := set.Codes()
+
+	results, err := v.validateCountriesChunked(v.withProgress(ctx, len(codes)), codes, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	byCode := make(map[string]ValidationResult, len(codes))
+	for i, result := range results {
+		byCode[codes[i]] = result
+	}
+
+	return byCode, v.invalidErrors(codes, results)
+}
+
+// validateCountriesChunked validates codes in chunks of the configured batch
+// size, run concurrently up to the limit set by WithConcurrency, and returns the
+// results in input order.
+func (v *Validator) validateCountriesChunked(ctx context.Context, codes []string, opts CountryOptions) ([]ValidationResult, error) {
+	results := make([]ValidationResult, len(codes))
+	chunks := (len(codes) + v.batchSize - 1) / v.batchSize
+
+	err := runConcurrent(ctx, v.concurrency, chunks, func(ctx context.Context, j int) error {
+		start := j * v.batchSize
+		end := min(start+v.batchSize, len(codes))
+
+		return v.retryChunk(ctx, func() error {
+			next := start
+			return v.StreamCountries(ctx, codes[start:end], opts, func(result ValidationResult) error {
+				results[next] = result
+				next++
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// echoServer starts a server that answers every code as valid, echoing it back
+// in the result's code, and returns a Validator for it configured with opts.
+func echoServer(t *testing.T, opts ...Option) *Validator {
+	t.Helper()
+	return newTestValidator(t, startEchoServer(t, nil).URL, opts...)
+}
+
+// startEchoServer starts a server like echoServer's. handle, if not nil, is
+// called first with the codes of each request and takes over the response by
+// returning true.
+func startEchoServer(t *testing.T, handle func(w http.ResponseWriter, r *http.Request, codes []string) bool) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Code any `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+
+		batch, isBatch := payload.Code.([]any)
+		codes := make([]string, len(batch))
+		for i, code := range batch {
+			codes[i] = code.(string)
+		}
+		if !isBatch {
+			codes = []string{payload.Code.(string)}
+		}
+
+		if handle != nil && handle(w, r, codes) {
+			return
+		}
+
+		if !isBatch {
+			json.NewEncoder(w).Encode(ValidationResult{Valid: true, Code: codes[0]})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"results": echoResults(codes)})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// echoResults returns a valid result for each code.
+func echoResults(codes []string) []ValidationResult {
+	results := make([]ValidationResult, len(codes))
+	for i, code := range codes {
+		results[i] = ValidationResult{Valid: true, Code: code}
+	}
+	return results
+}
+
+// newTestValidator returns a Validator for the server at url configured with opts.
+func newTestValidator(t *testing.T, url string, opts ...Option) *Validator {
+	t.Helper()
+
+	v, err := NewValidator("test-api-key", append([]Option{WithBaseURL(url)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestRequireContinentAppliesToEveryPath(t *testing.T) {
+	ctx := context.Background()
+	opts := CountryOptions{RequireContinent: Asia}
+
+	for _, tc := range []struct {
+		name    string
+		options []Option
+	}{
+		{"API", nil},
+		{"allowed by policy", []Option{WithCodePolicy([]string{"DE", "JP"}, nil)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := echoServer(t, tc.options...)
+
+			single, err := v.ValidateCountry(ctx, "DE", opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			multi, err := v.ValidateCountries(ctx, []string{"DE", "JP"}, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			batch, err := v.ValidateCountriesBatch(ctx, []CountryRequest{
+				{Code: "DE", Options: opts},
+				{Code: "JP", Options: opts},
+				{Code: "DE", Options: CountryOptions{RequireContinent: Asia, FollowUpward: true}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for name, result := range map[string]ValidationResult{
+				"ValidateCountry":            single,
+				"ValidateCountries":          multi[0],
+				"ValidateCountriesBatch":     batch[0],
+				"ValidateCountriesBatch{Up}": batch[2],
+			} {
+				if result.Valid || result.Message != "Country DE is not in Asia." {
+					t.Errorf("%s: got %+v, want DE invalid outside Asia", name, result)
+				}
+			}
+			if !multi[1].Valid || !batch[1].Valid {
+				t.Errorf("JP: got %+v and %+v, want valid", multi[1], batch[1])
+			}
+		})
+	}
+}
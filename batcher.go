@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrBatcherClosed is returned for codes added to a CountryBatcher after Close.
+var ErrBatcherClosed = errors.New("countriesdb: batcher closed")
+
+// CountryBatcher collects country codes added one at a time and validates them
+// together with ValidateCountries, flushing when maxSize codes are pending or
+// interval has passed since the first pending code was added, whichever comes
+// first. It is safe for concurrent use.
+type CountryBatcher struct {
+	v        *Validator
+	maxSize  int
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending []batchedCode
+	timer   *time.Timer
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+type batchedCode struct {
+	ctx  context.Context
+	code string
+	ch   chan CountryResult
+}
+
+// NewCountryBatcher returns a CountryBatcher that flushes every maxSize codes
+// (at least 1) or every interval. Close it when done to flush the codes still
+// pending.
+func (v *Validator) NewCountryBatcher(maxSize int, interval time.Duration) *CountryBatcher {
+	return &CountryBatcher{v: v, maxSize: max(maxSize, 1), interval: interval}
+}
+
+// Add queues code for the next flush and returns a channel that receives its
+// outcome and is then closed. The request of a flush is cancelled once the
+// contexts of all of its codes are done, and carries the values (such as a
+// WithAPIKey key) of the context of the first code added to it.
+func (b *CountryBatcher) Add(ctx context.Context, code string) <-chan CountryResult {
+	ch := make(chan CountryResult, 1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		ch <- CountryResult{Err: ErrBatcherClosed}
+		close(ch)
+		return ch
+	}
+
+	b.pending = append(b.pending, batchedCode{ctx: ctx, code: code, ch: ch})
+	switch {
+	case len(b.pending) >= b.maxSize:
+		b.flushLocked()
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(b.interval, b.flushTimer)
+	}
+
+	return ch
+}
+
+// Close flushes the pending codes, waits for all flushes to complete, and makes
+// later calls to Add fail with ErrBatcherClosed.
+func (b *CountryBatcher) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.flushLocked()
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+func (b *CountryBatcher) flushTimer() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flushLocked()
+}
+
+// flushLocked validates the pending codes in a new goroutine. b.mu must be held.
+func (b *CountryBatcher) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if len(b.pending) == 0 {
+		return
+	}
+
+	batch := b.pending
+	b.pending = nil
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.validate(batch)
+	}()
+}
+
+func (b *CountryBatcher) validate(batch []batchedCode) {
+	ctx, stop := batchContext(batch)
+	defer stop()
+
+	codes := make([]string, len(batch))
+	for i, item := range batch {
+		codes[i] = item.code
+	}
+
+	results, err := b.v.validateCountriesChunked(b.v.withProgress(ctx, len(codes)), codes, CountryOptions{})
+	if err == nil && len(results) != len(codes) {
+		err = fmt.Errorf("countriesdb: got %d results for %d codes", len(results), len(codes))
+	}
+
+	for i, item := range batch {
+		if err != nil {
+			item.ch <- CountryResult{Err: err}
+		} else {
+			item.ch <- CountryResult{Result: results[i], Err: b.v.invalidError(item.code, results[i])}
+		}
+		close(item.ch)
+	}
+}
+
+// batchContext returns a context with the values of the first item's context
+// that is cancelled once the contexts of all items are done.
+func batchContext(batch []batchedCode) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(batch[0].ctx))
+
+	remaining := int32(len(batch))
+	stops := make([]func() bool, len(batch))
+	for i, item := range batch {
+		stops[i] = context.AfterFunc(item.ctx, func() {
+			if atomic.AddInt32(&remaining, -1) == 0 {
+				cancel()
+			}
+		})
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordBatches starts an echo server that records the codes of each request.
+func recordBatches(t *testing.T) (*Validator, func() [][]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var batches [][]string
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, codes []string) bool {
+		mu.Lock()
+		batches = append(batches, codes)
+		mu.Unlock()
+		return false
+	})
+
+	return newTestValidator(t, srv.URL), func() [][]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][]string(nil), batches...)
+	}
+}
+
+func TestCountryBatcherFlushesOnSize(t *testing.T) {
+	v, batches := recordBatches(t)
+	b := v.NewCountryBatcher(3, time.Hour)
+	defer b.Close()
+
+	ctx := context.Background()
+	var chs []<-chan CountryResult
+	for _, code := range []string{"US", "CA", "MX", "FR"} {
+		chs = append(chs, b.Add(ctx, code))
+	}
+
+	for i, code := range []string{"US", "CA", "MX"} {
+		select {
+		case res := <-chs[i]:
+			if res.Err != nil || res.Result.Code != code {
+				t.Errorf("result %d = %+v, want %s", i, res, code)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no result for %s before the interval", code)
+		}
+	}
+
+	select {
+	case res := <-chs[3]:
+		t.Fatalf("FR was flushed early: %+v", res)
+	default:
+	}
+	if got, want := batches(), [][]string{{"US", "CA", "MX"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+}
+
+func TestCountryBatcherFlushesOnTimer(t *testing.T) {
+	v, batches := recordBatches(t)
+	b := v.NewCountryBatcher(100, 20*time.Millisecond)
+	defer b.Close()
+
+	ctx := context.Background()
+	first, second := b.Add(ctx, "US"), b.Add(ctx, "CA")
+
+	for _, ch := range []<-chan CountryResult{first, second} {
+		select {
+		case res := <-ch:
+			if res.Err != nil || !res.Result.Valid {
+				t.Errorf("got %+v, want valid", res)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timer didn't flush the batch")
+		}
+	}
+	if got, want := batches(), [][]string{{"US", "CA"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+}
+
+func TestCountryBatcherClose(t *testing.T) {
+	v, batches := recordBatches(t)
+	b := v.NewCountryBatcher(100, time.Hour)
+
+	ch := b.Add(context.Background(), "US")
+	b.Close()
+
+	if res := <-ch; res.Err != nil || res.Result.Code != "US" {
+		t.Errorf("got %+v, want US flushed by Close", res)
+	}
+	if res := <-b.Add(context.Background(), "CA"); res.Err != ErrBatcherClosed {
+		t.Errorf("got %v after Close, want ErrBatcherClosed", res.Err)
+	}
+	if got := len(batches()); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestBatchContextCancelsWhenAllDone(t *testing.T) {
+	type key struct{}
+	first, cancelFirst := context.WithCancel(context.WithValue(context.Background(), key{}, "first"))
+	second, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+
+	ctx, stop := batchContext([]batchedCode{{ctx: first}, {ctx: second}})
+	defer stop()
+
+	if ctx.Value(key{}) != "first" {
+		t.Error("batch context lacks the values of the first item's context")
+	}
+
+	cancelFirst()
+	select {
+	case <-ctx.Done():
+		t.Fatal("batch context cancelled while an item's context is live")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch context not cancelled after all items' contexts")
+	}
+}
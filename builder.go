@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"net/http"
+	"time"
+)
+
+// ValidatorBuilder builds a Validator with a fluent API, as an alternative to
+// passing options to NewValidator. Each method records the equivalent Option,
+// so both approaches produce identically configured validators.
+type ValidatorBuilder struct {
+	apiKey string
+	opts   []Option
+}
+
+// NewValidatorBuilder returns an empty ValidatorBuilder.
+func NewValidatorBuilder() *ValidatorBuilder {
+	return &ValidatorBuilder{}
+}
+
+// APIKey sets the API key.
+func (b *ValidatorBuilder) APIKey(key string) *ValidatorBuilder {
+	b.apiKey = key
+	return b
+}
+
+// BaseURL is equivalent to WithBaseURL.
+func (b *ValidatorBuilder) BaseURL(url string) *ValidatorBuilder {
+	return b.Option(WithBaseURL(url))
+}
+
+// HTTPClient is equivalent to WithHTTPClient.
+func (b *ValidatorBuilder) HTTPClient(client *http.Client) *ValidatorBuilder {
+	return b.Option(WithHTTPClient(client))
+}
+
+// Timeout is equivalent to WithTimeout.
+func (b *ValidatorBuilder) Timeout(d time.Duration) *ValidatorBuilder {
+	return b.Option(WithTimeout(d))
+}
+
+// Cache is equivalent to WithCache.
+func (b *ValidatorBuilder) Cache(size int, ttl time.Duration) *ValidatorBuilder {
+	return b.Option(WithCache(size, ttl))
+}
+
+// Retry is equivalent to WithRetry.
+func (b *ValidatorBuilder) Retry(maxRetries int) *ValidatorBuilder {
+	return b.Option(WithRetry(maxRetries))
+}
+
+// Backoff is equivalent to WithBackoff.
+func (b *ValidatorBuilder) Backoff(strategy BackoffStrategy) *ValidatorBuilder {
+	return b.Option(WithBackoff(strategy))
+}
+
+// Option adds options that have no dedicated builder method.
+func (b *ValidatorBuilder) Option(opts ...Option) *ValidatorBuilder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Build creates the Validator, exactly like NewValidator.
+func (b *ValidatorBuilder) Build() (*Validator, error) {
+	return NewValidator(b.apiKey, b.opts...)
+}
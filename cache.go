@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// WithCache caches up to size successful responses for ttl (forever if ttl is 0),
+// so repeated validations of the same input skip the API. Cached entries are keyed
+// by the exact request, so identical calls share them regardless of the method used.
+func WithCache(size int, ttl time.Duration) Option {
+	return func(v *Validator) {
+		if size <= 0 {
+			v.cache = nil
+			return
+		}
+		v.cache = newResponseCache(size, ttl)
+	}
+}
+
+// WithETags remembers the ETag and body of up to size responses that carry an
+// ETag header, and sends the ETag in If-None-Match when an identical request is
+// repeated. If the API answers 304 Not Modified, the remembered body is used
+// instead, saving bandwidth while still asking the API. Unlike WithCache it
+// never skips the request.
+func WithETags(size int) Option {
+	return func(v *Validator) {
+		if size <= 0 {
+			v.etags = nil
+			return
+		}
+		v.etags = newResponseCache(size, 0)
+	}
+}
+
+// cacheKey identifies a request by everything that can change its response.
+// Entries are scoped to the API key so tenants (see WithContextAPIKey) never share them.
+func (v *Validator) cacheKey(apiKey string, path string, body []byte) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:8]) + "\x00" + v.baseURL + path + "\x00" + v.language + "\x00" + string(body)
+}
+
+// responseCache is a size-bounded LRU cache of response bodies with expiry.
+type responseCache struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	order *list.List // front is most recently used
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key     string
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+func newResponseCache(size int, ttl time.Duration) *responseCache {
+	return &responseCache{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+	body, _, ok := c.getTagged(key)
+	return body, ok
+}
+
+// getTagged is like get but also returns the ETag stored with the body.
+func (c *responseCache) getTagged(key string) (body []byte, etag string, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, "", false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return nil, "", false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.body, entry.etag, true
+}
+
+func (c *responseCache) add(key string, body []byte) {
+	c.addTagged(key, body, "")
+}
+
+// addTagged is like add but also stores the ETag of the body.
+func (c *responseCache) addTagged(key string, body []byte, etag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, body: body, etag: etag, expires: time.Now().Add(c.ttl)}
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
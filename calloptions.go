@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"context"
+	"time"
+)
+
+// CallOption overrides the Validator's configuration for a single call. Pass
+// CallOptions to ValidateCountry, ValidateCountries, ValidateSubdivision or
+// ValidateSubdivisions, or attach them to the context of any other call with
+// WithCallOptions.
+type CallOption func(*callConfig)
+
+type callConfig struct {
+	timeout time.Duration
+	noRetry bool
+}
+
+type callOptionsKey struct{}
+
+// WithCallTimeout limits each request of the call, including its retries, to d.
+// It can only tighten the timeout set by WithTimeout, which still applies to
+// every attempt.
+func WithCallTimeout(d time.Duration) CallOption {
+	return func(c *callConfig) {
+		if d > 0 {
+			c.timeout = d
+		}
+	}
+}
+
+// WithNoRetry disables the retries configured by WithRetry for the call.
+func WithNoRetry() CallOption {
+	return func(c *callConfig) {
+		c.noRetry = true
+	}
+}
+
+// WithCallOptions returns a context whose validation requests apply opts on top
+// of those already attached to ctx.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	cfg := callConfigFrom(ctx)
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, cfg)
+}
+
+// callConfigFrom returns the call options attached to ctx.
+func callConfigFrom(ctx context.Context) callConfig {
+	cfg, _ := ctx.Value(callOptionsKey{}).(callConfig)
+	return cfg
+}
+
+// retriesFor returns the number of retries allowed for a call.
+func (v *Validator) retriesFor(ctx context.Context) int {
+	if callConfigFrom(ctx).noRetry {
+		return 0
+	}
+	return v.maxRetries
+}
+
+// withCallTimeout applies the WithCallTimeout of ctx, if any, to a request.
+func withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := callConfigFrom(ctx).timeout; timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
@@ -0,0 +1,84 @@
+package validator
+
+import "sort"
+
+// CountryCodeSet is a set of ISO 3166-1 alpha-2 codes, stored uppercased. Codes
+// are trimmed and uppercased on insertion and lookup; codes that aren't two ASCII
+// letters are ignored. The zero value is an empty set that can't be added to; use
+// NewCountryCodeSet. A set is safe for concurrent reads but not for concurrent
+// writes.
+type CountryCodeSet map[string]struct{}
+
+// NewCountryCodeSet returns a set containing codes.
+func NewCountryCodeSet(codes ...string) CountryCodeSet {
+	s := make(CountryCodeSet, len(codes))
+	s.Add(codes...)
+	return s
+}
+
+// Contains reports whether code is in s.
+func (s CountryCodeSet) Contains(code string) bool {
+	return inCodeSet(s, code)
+}
+
+// Add adds codes to s, ignoring those that aren't two ASCII letters.
+func (s CountryCodeSet) Add(codes ...string) {
+	for _, code := range codes {
+		if normalized := normalizeAlpha2(code); IsValidCountryCodeFormat(normalized) {
+			s[normalized] = struct{}{}
+		}
+	}
+}
+
+// Remove removes codes from s.
+func (s CountryCodeSet) Remove(codes ...string) {
+	for _, code := range codes {
+		delete(s, normalizeAlpha2(code))
+	}
+}
+
+// Len returns the number of codes in s.
+func (s CountryCodeSet) Len() int {
+	return len(s)
+}
+
+// Codes returns the codes in s in sorted order.
+func (s CountryCodeSet) Codes() []string {
+	codes := codeSetKeys(s)
+	sort.Strings(codes)
+	return codes
+}
+
+// Union returns a new set with the codes in s or other.
+func (s CountryCodeSet) Union(other CountryCodeSet) CountryCodeSet {
+	union := make(CountryCodeSet, len(s)+len(other))
+	for code := range s {
+		union[code] = struct{}{}
+	}
+	for code := range other {
+		union[code] = struct{}{}
+	}
+	return union
+}
+
+// Intersection returns a new set with the codes in both s and other.
+func (s CountryCodeSet) Intersection(other CountryCodeSet) CountryCodeSet {
+	intersection := make(CountryCodeSet)
+	for code := range s {
+		if _, ok := other[code]; ok {
+			intersection[code] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new set with the codes in s that aren't in other.
+func (s CountryCodeSet) Difference(other CountryCodeSet) CountryCodeSet {
+	difference := make(CountryCodeSet)
+	for code := range s {
+		if _, ok := other[code]; !ok {
+			difference[code] = struct{}{}
+		}
+	}
+	return difference
+}
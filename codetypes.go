@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CountryCode is a normalized ISO 3166-1 alpha-2 code such as "US". Obtain one
+// with ParseCountryCode so that country and subdivision codes can't be mixed up;
+// the Validate* methods taking plain strings keep working alongside it.
+type CountryCode string
+
+// ParseCountryCode normalizes raw like NormalizeCountryCode and returns it as a
+// CountryCode. It returns an error wrapping ErrInvalidFormat or ErrUnknownCountry
+// if raw isn't an assigned country code. Runs offline.
+func ParseCountryCode(raw string) (CountryCode, error) {
+	code, err := NormalizeCountryCode(raw)
+	if err != nil {
+		return "", err
+	}
+	return CountryCode(code), nil
+}
+
+// String returns c as a string.
+func (c CountryCode) String() string {
+	return string(c)
+}
+
+// SubdivisionCode is a normalized ISO 3166-2 code including its country prefix,
+// such as "US-CA". Obtain one with ParseSubdivisionCode.
+type SubdivisionCode string
+
+// ParseSubdivisionCode normalizes raw like NormalizeSubdivisionCode and returns it
+// prefixed with the country, e.g. "California" or "ca" for "US" becomes "US-CA".
+// It returns an error wrapping ErrInvalidFormat if raw can't be resolved. Runs offline.
+func ParseSubdivisionCode(raw string, country CountryCode) (SubdivisionCode, error) {
+	code, err := NormalizeSubdivisionCode(raw, string(country))
+	if err != nil {
+		return "", err
+	}
+	prefix, _ := countryParam(string(country))
+	return SubdivisionCode(prefix + "-" + code), nil
+}
+
+// String returns s as a string.
+func (s SubdivisionCode) String() string {
+	return string(s)
+}
+
+// Country returns the country part of s, e.g. "US" for "US-CA".
+func (s SubdivisionCode) Country() CountryCode {
+	country, _, _ := strings.Cut(string(s), "-")
+	return CountryCode(country)
+}
+
+// ValidateCountryCode validates a CountryCode like ValidateCountry.
+func (v *Validator) ValidateCountryCode(ctx context.Context, code CountryCode, opts CountryOptions) (ValidationResult, error) {
+	return v.ValidateCountry(ctx, string(code), opts)
+}
+
+// ValidateSubdivisionCode validates a SubdivisionCode like ValidateSubdivision,
+// using the country it carries.
+func (v *Validator) ValidateSubdivisionCode(ctx context.Context, code SubdivisionCode, opts SubdivisionOptions) (ValidationResult, error) {
+	if _, _, found := strings.Cut(string(code), "-"); !found {
+		return ValidationResult{}, fmt.Errorf("%w: subdivision code %q has no country prefix", ErrInvalidFormat, code)
+	}
+	return v.ValidateSubdivision(ctx, string(code), string(code.Country()), opts)
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultConcurrency = 4
+
+// WithConcurrency limits how many requests a single batch call sends in
+// parallel when it needs more than one (defaults to 4). The limit covers the
+// whole call, including requests it fans out to further, e.g. the chunks of
+// each country in ValidateSubdivisionPairs.
+func WithConcurrency(n int) Option {
+	return func(v *Validator) {
+		if n > 0 {
+			v.concurrency = n
+		}
+	}
+}
+
+// concurrencyLimitKey carries the slots shared by all nested runConcurrent
+// calls of one top-level call, so WithConcurrency bounds the call as a whole.
+type concurrencyLimitKey struct{}
+
+// heldSlotKey marks a context whose goroutine already holds one of its slots.
+type heldSlotKey struct{}
+
+// concurrencySlots returns the slots of the top-level call ctx belongs to, and
+// whether the calling goroutine holds one of them. Without any, it returns a
+// context carrying limit new slots.
+func concurrencySlots(ctx context.Context, limit int) (context.Context, chan struct{}, bool) {
+	if slots, ok := ctx.Value(concurrencyLimitKey{}).(chan struct{}); ok {
+		held, _ := ctx.Value(heldSlotKey{}).(bool)
+		return ctx, slots, held
+	}
+	slots := make(chan struct{}, limit)
+	return context.WithValue(ctx, concurrencyLimitKey{}, slots), slots, false
+}
+
+// runConcurrent calls fn for every index in [0, n) with at most limit calls in
+// flight. After the first error it cancels the context passed to the remaining
+// calls, starts no new ones, and returns that error once all calls have finished.
+//
+// Nested calls, made from within fn, share the limit of the outermost call
+// instead of applying their own: the calling goroutine keeps working with the
+// slot it holds, and further workers start only as slots become free.
+func runConcurrent(ctx context.Context, limit int, n int, fn func(ctx context.Context, i int) error) error {
+	limitCtx, slots, held := concurrencySlots(ctx, limit)
+	jobCtx, cancel := context.WithCancel(limitCtx)
+	defer cancel()
+	workerCtx := context.WithValue(jobCtx, heldSlotKey{}, true)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		next     atomic.Int64
+	)
+
+	work := func() {
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= n || jobCtx.Err() != nil {
+				return
+			}
+			if err := fn(workerCtx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}
+	}
+
+	// spawn starts a worker for each free slot while jobs are left unclaimed.
+	claimed := make(chan struct{})
+	spawn := func() {
+		for int(next.Load()) < n {
+			select {
+			case slots <- struct{}{}:
+			case <-jobCtx.Done():
+				return
+			case <-claimed:
+				return
+			}
+			if int(next.Load()) >= n {
+				<-slots
+				return
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				work()
+			}()
+		}
+	}
+
+	if held {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			spawn()
+		}()
+		work()
+		close(claimed)
+	} else {
+		spawn()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// peakCounter tracks the highest number of concurrent holders.
+type peakCounter struct {
+	current, peak atomic.Int32
+}
+
+func (c *peakCounter) enter() {
+	n := c.current.Add(1)
+	for {
+		peak := c.peak.Load()
+		if n <= peak || c.peak.CompareAndSwap(peak, n) {
+			return
+		}
+	}
+}
+
+func (c *peakCounter) leave() { c.current.Add(-1) }
+
+func TestRunConcurrentNestedSharesLimit(t *testing.T) {
+	for _, limit := range []int{1, 2, 3} {
+		t.Run(fmt.Sprint(limit), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			var peak peakCounter
+			var leaves atomic.Int32
+			err := runConcurrent(ctx, limit, 4, func(ctx context.Context, i int) error {
+				return runConcurrent(ctx, limit, 3, func(ctx context.Context, j int) error {
+					return runConcurrent(ctx, limit, 2, func(ctx context.Context, k int) error {
+						peak.enter()
+						defer peak.leave()
+						time.Sleep(time.Millisecond)
+						leaves.Add(1)
+						return nil
+					})
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := leaves.Load(); n != 4*3*2 {
+				t.Errorf("ran %d calls, want %d", n, 4*3*2)
+			}
+			if p := peak.peak.Load(); p > int32(limit) {
+				t.Errorf("peak of %d concurrent calls, want at most %d", p, limit)
+			}
+		})
+	}
+}
+
+func TestRunConcurrentStopsAfterError(t *testing.T) {
+	fail := errors.New("fail")
+	var calls atomic.Int32
+
+	err := runConcurrent(context.Background(), 1, 10, func(ctx context.Context, i int) error {
+		calls.Add(1)
+		if i == 2 {
+			return fail
+		}
+		return nil
+	})
+	if err != fail {
+		t.Fatalf("got %v, want the first error", err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("made %d calls, want 3", n)
+	}
+}
+
+func TestValidateSubdivisionPairsConcurrencyLimit(t *testing.T) {
+	for _, limit := range []int{1, 2} {
+		t.Run(fmt.Sprint(limit), func(t *testing.T) {
+			var peak peakCounter
+			srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, codes []string) bool {
+				peak.enter()
+				defer peak.leave()
+				time.Sleep(2 * time.Millisecond)
+				return false
+			})
+			v := newTestValidator(t, srv.URL, WithBatchSize(2), WithConcurrency(limit))
+
+			var pairs []SubdivisionRef
+			for _, country := range []string{"US", "CA", "FR", "DE"} {
+				for i := 0; i < 5; i++ {
+					pairs = append(pairs, SubdivisionRef{Code: fmt.Sprintf("%s-A%d", country, i), Country: country})
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			// FollowRelated sends every code on its own, nesting three levels:
+			// countries, chunks and codes.
+			for _, opts := range []SubdivisionOptions{{}, {FollowRelated: true}} {
+				results, err := v.ValidateSubdivisionPairs(ctx, pairs, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i, result := range results {
+					if result.Code != pairs[i].Code {
+						t.Fatalf("result %d is for %s, want %s", i, result.Code, pairs[i].Code)
+					}
+				}
+			}
+			if p := peak.peak.Load(); p > int32(limit) {
+				t.Errorf("peak of %d concurrent requests, want at most %d", p, limit)
+			}
+		})
+	}
+}
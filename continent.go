@@ -0,0 +1,168 @@
+package validator
+
+import "fmt"
+
+// Continent is a continent, identified by its two-letter code.
+type Continent string
+
+// Continents.
+const (
+	Africa       Continent = "AF"
+	Antarctica   Continent = "AN"
+	Asia         Continent = "AS"
+	Europe       Continent = "EU"
+	NorthAmerica Continent = "NA"
+	Oceania      Continent = "OC"
+	SouthAmerica Continent = "SA"
+)
+
+// String returns the name of c, e.g. "North America", or its code if unknown.
+func (c Continent) String() string {
+	switch c {
+	case Africa:
+		return "Africa"
+	case Antarctica:
+		return "Antarctica"
+	case Asia:
+		return "Asia"
+	case Europe:
+		return "Europe"
+	case NorthAmerica:
+		return "North America"
+	case Oceania:
+		return "Oceania"
+	case SouthAmerica:
+		return "South America"
+	default:
+		return string(c)
+	}
+}
+
+// GroupByContinent groups results by their Continent, keeping their order within
+// each group. Results whose continent isn't known are grouped under "".
+func GroupByContinent(results []ValidationResult) map[Continent][]ValidationResult {
+	groups := make(map[Continent][]ValidationResult)
+	for _, result := range results {
+		groups[result.Continent] = append(groups[result.Continent], result)
+	}
+	return groups
+}
+
+// continentCountries lists the countries of each continent by the continent on
+// which the majority of their territory lies.
+var continentCountries = map[Continent][]string{
+	Africa: {
+		"AO", "BF", "BI", "BJ", "BW", "CD", "CF", "CG", "CI", "CM", "CV", "DJ",
+		"DZ", "EG", "EH", "ER", "ET", "GA", "GH", "GM", "GN", "GQ", "GW", "KE",
+		"KM", "LR", "LS", "LY", "MA", "MG", "ML", "MR", "MU", "MW", "MZ", "NA",
+		"NE", "NG", "RE", "RW", "SC", "SD", "SH", "SL", "SN", "SO", "SS", "ST",
+		"SZ", "TD", "TG", "TN", "TZ", "UG", "YT", "ZA", "ZM", "ZW",
+	},
+	Antarctica: {
+		"AQ", "BV", "GS", "HM", "TF",
+	},
+	Asia: {
+		"AE", "AF", "AM", "AZ", "BD", "BH", "BN", "BT", "CC", "CN", "CX", "CY",
+		"GE", "HK", "ID", "IL", "IN", "IO", "IQ", "IR", "JO", "JP", "KG", "KH",
+		"KP", "KR", "KW", "KZ", "LA", "LB", "LK", "MM", "MN", "MO", "MV", "MY",
+		"NP", "OM", "PH", "PK", "PS", "QA", "RU", "SA", "SG", "SY", "TH", "TJ",
+		"TL", "TM", "TR", "TW", "UZ", "VN", "YE",
+	},
+	Europe: {
+		"AD", "AL", "AT", "AX", "BA", "BE", "BG", "BY", "CH", "CZ", "DE", "DK",
+		"EE", "ES", "FI", "FO", "FR", "GB", "GG", "GI", "GR", "HR", "HU", "IE",
+		"IM", "IS", "IT", "JE", "LI", "LT", "LU", "LV", "MC", "MD", "ME", "MK",
+		"MT", "NL", "NO", "PL", "PT", "RO", "RS", "SE", "SI", "SJ", "SK", "SM",
+		"UA", "VA",
+	},
+	NorthAmerica: {
+		"AG", "AI", "AW", "BB", "BL", "BM", "BQ", "BS", "BZ", "CA", "CR", "CU",
+		"CW", "DM", "DO", "GD", "GL", "GP", "GT", "HN", "HT", "JM", "KN", "KY",
+		"LC", "MF", "MQ", "MS", "MX", "NI", "PA", "PM", "PR", "SV", "SX", "TC",
+		"TT", "US", "VC", "VG", "VI",
+	},
+	Oceania: {
+		"AS", "AU", "CK", "FJ", "FM", "GU", "KI", "MH", "MP", "NC", "NF", "NR",
+		"NU", "NZ", "PF", "PG", "PN", "PW", "SB", "TK", "TO", "TV", "UM", "VU",
+		"WF", "WS",
+	},
+	SouthAmerica: {
+		"AR", "BO", "BR", "CL", "CO", "EC", "FK", "GF", "GY", "PE", "PY", "SR",
+		"UY", "VE",
+	},
+}
+
+// transcontinental lists the continents of countries spanning more than one,
+// primary continent first.
+var transcontinental = map[string][]Continent{
+	"AZ": {Asia, Europe},
+	"EG": {Africa, Asia},
+	"ES": {Europe, Africa},
+	"GE": {Asia, Europe},
+	"ID": {Asia, Oceania},
+	"KZ": {Asia, Europe},
+	"PA": {NorthAmerica, SouthAmerica},
+	"RU": {Asia, Europe},
+	"TR": {Asia, Europe},
+}
+
+var countryContinent = func() map[string]Continent {
+	m := make(map[string]Continent)
+	for continent, codes := range continentCountries {
+		for _, code := range codes {
+			m[code] = continent
+		}
+	}
+	return m
+}()
+
+// ContinentOf returns the continent of alpha2. For transcontinental countries it
+// is the continent on which the majority of the territory lies, e.g. Asia for
+// Russia and Africa for Egypt; see ContinentsOf. It returns an error wrapping
+// ErrUnknownCountry for codes that are not assigned ISO 3166-1 codes. Runs offline.
+func ContinentOf(alpha2 string) (Continent, error) {
+	continent, ok := countryContinent[normalizeAlpha2(alpha2)]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCountry, alpha2)
+	}
+	return continent, nil
+}
+
+// ContinentsOf returns every continent alpha2 lies on, starting with the one
+// returned by ContinentOf, e.g. [Asia Europe] for Russia. Runs offline.
+func ContinentsOf(alpha2 string) ([]Continent, error) {
+	continent, err := ContinentOf(alpha2)
+	if err != nil {
+		return nil, err
+	}
+
+	if continents, ok := transcontinental[normalizeAlpha2(alpha2)]; ok {
+		return append([]Continent(nil), continents...), nil
+	}
+	return []Continent{continent}, nil
+}
+
+// CountriesInContinent returns the alpha-2 codes of the countries whose primary
+// continent (see ContinentOf) is c, sorted alphabetically. It returns an error if
+// c isn't one of the Continent constants. Runs offline.
+func CountriesInContinent(c Continent) ([]string, error) {
+	codes, ok := continentCountries[c]
+	if !ok {
+		return nil, fmt.Errorf("countriesdb: unknown continent %q", c)
+	}
+	return append([]string(nil), codes...), nil
+}
+
+// requireContinent marks a valid result invalid if opts.RequireContinent is set
+// and isn't the primary continent of the result's country.
+func requireContinent(result ValidationResult, opts CountryOptions) ValidationResult {
+	if !result.Valid || opts.RequireContinent == "" {
+		return result
+	}
+
+	if continent, _ := ContinentOf(result.Code); continent != opts.RequireContinent {
+		result.Valid = false
+		result.Message = fmt.Sprintf("Country %s is not in %s.", result.Code, opts.RequireContinent)
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ValidateCountriesFromCSV reads CSV records from r, validates the country code in
+// column codeColumn (zero-based) of every record, and returns one result per record
+// in row order. When hasHeader is set, the first record is skipped. Codes are
+// validated like ValidateCountries.
+//
+// A record that can't be parsed or has no codeColumn yields
+// ValidationResult{Valid: false, Message: "CSV parse error: ..."} instead of
+// aborting; only read errors from r are returned.
+func (v *Validator) ValidateCountriesFromCSV(ctx context.Context, r io.Reader, codeColumn int, hasHeader bool, opts CountryOptions) ([]ValidationResult, error) {
+	rows, results, err := readCSVColumns(r, hasHeader, codeColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []int
+	var codes []string
+	for i, row := range rows {
+		if row != nil {
+			indexes = append(indexes, i)
+			codes = append(codes, row[0])
+		}
+	}
+
+	batch, err := v.ValidateCountries(ctx, codes, opts)
+	if batch == nil {
+		return nil, err
+	}
+
+	for j, i := range indexes {
+		results[i] = batch[j]
+	}
+
+	return results, err
+}
+
+// ValidateSubdivisionsFromCSV reads CSV records from r, validates the subdivision
+// code in column codeColumn against the country in column countryColumn (both
+// zero-based), and returns one result per record in row order. When hasHeader is
+// set, the first record is skipped. Records are validated like
+// ValidateSubdivisionPairs.
+//
+// A record that can't be parsed or lacks either column yields
+// ValidationResult{Valid: false, Message: "CSV parse error: ..."} instead of
+// aborting; only read errors from r are returned.
+func (v *Validator) ValidateSubdivisionsFromCSV(ctx context.Context, r io.Reader, countryColumn, codeColumn int, hasHeader bool, opts SubdivisionOptions) ([]ValidationResult, error) {
+	rows, results, err := readCSVColumns(r, hasHeader, countryColumn, codeColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []int
+	var pairs []SubdivisionRef
+	for i, row := range rows {
+		if row != nil {
+			indexes = append(indexes, i)
+			pairs = append(pairs, SubdivisionRef{Country: row[0], Code: row[1]})
+		}
+	}
+
+	batch, err := v.ValidateSubdivisionPairs(ctx, pairs, opts)
+	if batch == nil {
+		return nil, err
+	}
+
+	for j, i := range indexes {
+		results[i] = batch[j]
+	}
+
+	return results, err
+}
+
+// readCSVColumns reads every record from r and extracts the given columns,
+// trimmed of surrounding whitespace. rows[i] holds the values of record i, or nil
+// when the record couldn't be used, in which case results[i] already holds the
+// "CSV parse error" result for it. results has one entry per record.
+func readCSVColumns(r io.Reader, hasHeader bool, columns ...int) (rows [][]string, results []ValidationResult, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if first && hasHeader {
+			if err != nil && !isCSVParseError(err) {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		if err != nil {
+			if !isCSVParseError(err) {
+				return nil, nil, err
+			}
+			rows = append(rows, nil)
+			results = append(results, csvErrorResult(err.Error()))
+			continue
+		}
+
+		row, err := csvColumns(record, columns)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			rows = append(rows, nil)
+			results = append(results, csvErrorResult(fmt.Sprintf("record on line %d: %v", line, err)))
+			continue
+		}
+
+		rows = append(rows, row)
+		results = append(results, ValidationResult{})
+	}
+
+	return rows, results, nil
+}
+
+func csvColumns(record []string, columns []int) ([]string, error) {
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		if column < 0 || column >= len(record) {
+			return nil, fmt.Errorf("missing column %d", column)
+		}
+		row[i] = strings.TrimSpace(record[column])
+	}
+	return row, nil
+}
+
+func isCSVParseError(err error) bool {
+	var parseErr *csv.ParseError
+	return errors.As(err, &parseErr)
+}
+
+func csvErrorResult(msg string) ValidationResult {
+	return ValidationResult{Valid: false, Message: "CSV parse error: " + msg}
+}
@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CodeValidator validates country and subdivision codes. It is implemented by
+// Validator, which asks the API, and by OfflineValidator, which uses a dataset
+// snapshot, so code can be written against either.
+type CodeValidator interface {
+	ValidateCountry(ctx context.Context, code string, opts CountryOptions, callOpts ...CallOption) (ValidationResult, error)
+	ValidateSubdivision(ctx context.Context, code string, country string, opts SubdivisionOptions, callOpts ...CallOption) (ValidationResult, error)
+}
+
+var (
+	_ CodeValidator = (*Validator)(nil)
+	_ CodeValidator = (*OfflineValidator)(nil)
+)
+
+// Dataset is a snapshot of the CountriesDB dataset as read by LoadDataset. It is
+// encoded as a JSON object such as:
+//
+//	{
+//	  "revision": "2024-11-01",
+//	  "countries": [
+//	    {"code": "ES", "name": "Spain"}
+//	  ],
+//	  "subdivisions": [
+//	    {"code": "ES-M", "country": "ES", "name": "Madrid"},
+//	    {"code": "ES-MD", "country": "ES", "name": "Madrid, Comunidad de", "parent": "ES-M"}
+//	  ]
+//	}
+//
+// Country codes are ISO 3166-1 alpha-2 codes. Subdivision codes are ISO 3166-2
+// codes, in the full form ("ES-MD") or without the country prefix ("MD").
+// Parent, if set, is the code of the subdivision's parent subdivision, which
+// must be in the snapshot too. Revision is optional and copied to the results.
+type Dataset struct {
+	Revision     string               `json:"revision,omitempty"`
+	Countries    []DatasetCountry     `json:"countries"`
+	Subdivisions []DatasetSubdivision `json:"subdivisions"`
+}
+
+// DatasetCountry is a country in a Dataset.
+type DatasetCountry struct {
+	Code string `json:"code"`
+	Name string `json:"name,omitempty"`
+}
+
+// DatasetSubdivision is a subdivision in a Dataset.
+type DatasetSubdivision struct {
+	Code    string `json:"code"`
+	Country string `json:"country"`
+	Name    string `json:"name,omitempty"`
+	Parent  string `json:"parent,omitempty"`
+}
+
+// OfflineValidator validates codes against a dataset snapshot without network
+// access, for deployments that can't reach the API. Create one with
+// LoadDataset. It is safe for concurrent use.
+type OfflineValidator struct {
+	revision     string
+	countries    map[string]DatasetCountry
+	subdivisions map[string]DatasetSubdivision // by full code, with canonical Code and Parent
+}
+
+// LoadDataset reads a Dataset encoded as JSON from r and returns an
+// OfflineValidator for it. It returns an error if the snapshot can't be decoded
+// or is inconsistent: malformed or duplicate codes, subdivisions of countries
+// missing from it, or unknown or cyclic parents.
+func LoadDataset(r io.Reader) (*OfflineValidator, error) {
+	var dataset Dataset
+	if err := json.NewDecoder(r).Decode(&dataset); err != nil {
+		return nil, fmt.Errorf("countriesdb: decoding dataset: %w", err)
+	}
+
+	o := &OfflineValidator{
+		revision:     dataset.Revision,
+		countries:    make(map[string]DatasetCountry, len(dataset.Countries)),
+		subdivisions: make(map[string]DatasetSubdivision, len(dataset.Subdivisions)),
+	}
+
+	for _, country := range dataset.Countries {
+		code := normalizeAlpha2(country.Code)
+		if !IsValidCountryCodeFormat(code) {
+			return nil, fmt.Errorf("countriesdb: dataset: invalid country code %q", country.Code)
+		}
+		if _, ok := o.countries[code]; ok {
+			return nil, fmt.Errorf("countriesdb: dataset: duplicate country %s", code)
+		}
+		country.Code = code
+		o.countries[code] = country
+	}
+
+	for _, sub := range dataset.Subdivisions {
+		country := normalizeAlpha2(sub.Country)
+		if _, ok := o.countries[country]; !ok {
+			return nil, fmt.Errorf("countriesdb: dataset: subdivision %q of unknown country %q", sub.Code, sub.Country)
+		}
+		code, ok := fullSubdivisionCode(sub.Code, country)
+		if !ok {
+			return nil, fmt.Errorf("countriesdb: dataset: invalid subdivision code %q of %s", sub.Code, country)
+		}
+		if _, ok := o.subdivisions[code]; ok {
+			return nil, fmt.Errorf("countriesdb: dataset: duplicate subdivision %s", code)
+		}
+		if sub.Parent != "" {
+			parent, ok := fullSubdivisionCode(sub.Parent, country)
+			if !ok {
+				return nil, fmt.Errorf("countriesdb: dataset: invalid parent %q of subdivision %s", sub.Parent, code)
+			}
+			sub.Parent = parent
+		}
+		sub.Code = code
+		sub.Country = country
+		o.subdivisions[code] = sub
+	}
+
+	for code, sub := range o.subdivisions {
+		for depth := 0; sub.Parent != ""; depth++ {
+			parent, ok := o.subdivisions[sub.Parent]
+			if !ok {
+				return nil, fmt.Errorf("countriesdb: dataset: subdivision %s has unknown parent %s", code, sub.Parent)
+			}
+			if depth == len(o.subdivisions) {
+				return nil, fmt.Errorf("countriesdb: dataset: subdivision %s has cyclic parents", code)
+			}
+			sub = parent
+		}
+	}
+
+	return o, nil
+}
+
+// fullSubdivisionCode returns the uppercased full form ("US-CA") of a subdivision
+// code of country given in either form; ok is false if it isn't well-formed.
+func fullSubdivisionCode(code, country string) (full string, ok bool) {
+	normalized, ok := normalizeSubdivisionCode(code)
+	if !ok || !IsValidSubdivisionCodeFormat(normalized, country) {
+		return "", false
+	}
+	if len(normalized) > 2 && normalized[2] == '-' {
+		return normalized, true
+	}
+	return country + "-" + normalized, true
+}
+
+// ValidateCountry validates a single country code against the snapshot, like
+// Validator.ValidateCountry. opts.FollowUpward and callOpts have no effect.
+func (o *OfflineValidator) ValidateCountry(ctx context.Context, code string, opts CountryOptions, callOpts ...CallOption) (ValidationResult, error) {
+	code = strings.TrimSpace(code)
+	if !IsValidCountryCodeFormat(code) {
+		return ValidationResult{}, fmt.Errorf("%w: country code %q must be two ASCII letters", ErrInvalidFormat, code)
+	}
+
+	upper, _ := asciiUpper(code)
+	country, ok := o.countries[upper]
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code.", Code: upper, Revision: o.revision}, nil
+	}
+
+	result := ValidationResult{
+		Valid:     true,
+		Code:      upper,
+		Name:      country.Name,
+		Revision:  o.revision,
+		MatchKind: MatchExact,
+	}
+	return requireContinent(result, opts), nil
+}
+
+// ValidateSubdivision validates a single subdivision of country against the
+// snapshot, like Validator.ValidateSubdivision. The result's Code is the full
+// form of the code, e.g. "US-CA". opts.IncludeAncestors fills Ancestors from
+// the snapshot's parents; opts.FollowRelated, opts.AllowParentSelection and
+// callOpts have no effect.
+func (o *OfflineValidator) ValidateSubdivision(ctx context.Context, code string, country string, opts SubdivisionOptions, callOpts ...CallOption) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	normalized, ok := normalizeSubdivisionCode(code)
+	if !ok {
+		return ValidationResult{Valid: false, Message: nonASCIISubdivisionMessage}, nil
+	}
+
+	full, ok := fullSubdivisionCode(normalized, country)
+	sub, found := o.subdivisions[full]
+	if !ok || !found {
+		return ValidationResult{Valid: false, Message: "Invalid subdivision code.", Code: normalized, Revision: o.revision}, nil
+	}
+
+	result := ValidationResult{
+		Valid:     true,
+		Code:      sub.Code,
+		Name:      sub.Name,
+		Revision:  o.revision,
+		MatchKind: MatchExact,
+	}
+	if opts.IncludeAncestors {
+		for parent := sub.Parent; parent != ""; parent = o.subdivisions[parent].Parent {
+			result.Ancestors = append(result.Ancestors, parent)
+		}
+		result.Ancestors = append(result.Ancestors, country)
+	}
+
+	return result, nil
+}
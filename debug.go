@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// WithDebug writes a redacted dump of every request and response to w.
+// The API key is masked in the Authorization header (or the header set by
+// WithAuthHeader), so it is never written.
+func WithDebug(w io.Writer) Option {
+	return func(v *Validator) {
+		v.debug = w
+	}
+}
+
+// dumpRequest writes the method, URL, headers and body of req to the debug writer.
+func (v *Validator) dumpRequest(req *http.Request, body []byte) {
+	fmt.Fprintf(v.debug, "> %s %s\n", req.Method, req.URL)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			if name == v.authHeader {
+				value = v.authValue("****")
+			}
+			fmt.Fprintf(v.debug, "> %s: %s\n", name, value)
+		}
+	}
+
+	fmt.Fprintf(v.debug, ">\n%s\n", body)
+}
+
+// dumpResponse writes the status of resp and its body data to the debug writer.
+func (v *Validator) dumpResponse(resp *http.Response, data []byte) {
+	fmt.Fprintf(v.debug, "< %s\n<\n%s\n", resp.Status, data)
+}
+
+// requestLogLimit caps how many bytes of each body WithRequestLogging writes.
+const requestLogLimit = 4 << 10
+
+// WithRequestLogging writes the JSON body of every request and response to w,
+// one line each, prefixed with the path and the response status. Bodies longer
+// than 4KB are truncated. Headers are not logged, so the API key is never written,
+// but bodies contain the codes being validated: use it while developing, not in
+// production.
+func WithRequestLogging(w io.Writer) Option {
+	return func(v *Validator) {
+		v.requestLog = w
+	}
+}
+
+func (v *Validator) logRequest(path string, body []byte) {
+	fmt.Fprintf(v.requestLog, "--> POST %s %s\n", path, snippet(body, requestLogLimit))
+}
+
+func (v *Validator) logResponse(path string, resp *http.Response, data []byte) {
+	fmt.Fprintf(v.requestLog, "<-- %d %s %s\n", resp.StatusCode, path, snippet(data, requestLogLimit))
+}
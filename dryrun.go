@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// dryRunConfig is set by WithDryRun and WithDryRunResult.
+type dryRunConfig struct {
+	fn    func(path string, payload map[string]any)
+	valid bool
+}
+
+// WithDryRun makes the Validator send nothing: every API call instead passes the
+// endpoint path and the JSON payload it would have sent to fn, and reports every
+// code as valid. Use it in tests to assert on the requests your code builds
+// without network access or quota use. Never use it in production: all input
+// is accepted.
+func WithDryRun(fn func(path string, payload map[string]any)) Option {
+	return func(v *Validator) {
+		v.dryRun = &dryRunConfig{fn: fn, valid: true}
+	}
+}
+
+// WithDryRunResult makes the Validator send nothing: every API call immediately
+// reports every code as valid, or every code as invalid if valid is false. Use it
+// in tests and CI to exercise code paths without caring about results. Input
+// rejected locally (e.g. a malformed country code) is still rejected. Combined
+// with WithDryRun, the callback is kept and valid sets the result. Never use it
+// in production.
+func WithDryRunResult(valid bool) Option {
+	return func(v *Validator) {
+		cfg := dryRunConfig{valid: valid}
+		if v.dryRun != nil {
+			cfg.fn = v.dryRun.fn
+		}
+		v.dryRun = &cfg
+	}
+}
+
+// postDryRun hands payload to the WithDryRun callback, if any, and decodes a
+// synthetic response into out in place of the API's: one result, or one result
+// per code sent for batch requests.
+func (v *Validator) postDryRun(path string, payload map[string]any, out any) error {
+	if v.dryRun.fn != nil {
+		v.dryRun.fn(path, payload)
+	}
+
+	result := ValidationResult{Valid: v.dryRun.valid}
+	if !result.Valid {
+		result.Message = "Dry run."
+	}
+
+	var response any = result
+	if codes, ok := payload["code"].([]string); ok {
+		results := make([]ValidationResult, len(codes))
+		for i := range results {
+			results[i] = result
+		}
+		response = map[string]any{"results": results}
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	return v.decodeResponse(path, bytes.NewReader(body), out)
+}
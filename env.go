@@ -0,0 +1,28 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// WithBaseURLFromEnv reads the API base URL from the environment variable envKey
+// when the Validator is created, e.g. to point staging deployments at a staging
+// API. It behaves like WithBaseURL, so if the variable is unset or empty the
+// default (or an earlier WithBaseURL) is kept.
+func WithBaseURLFromEnv(envKey string) Option {
+	return func(v *Validator) {
+		WithBaseURL(os.Getenv(envKey))(v)
+	}
+}
+
+// NewValidatorFromEnv creates a Validator like NewValidator, reading the API key
+// from the environment variable envKey, e.g. "COUNTRIESDB_PRIVATE_KEY". It returns
+// an error wrapping ErrMissingAPIKey if the variable is unset or blank.
+func NewValidatorFromEnv(envKey string, opts ...Option) (*Validator, error) {
+	v, err := NewValidator(os.Getenv(envKey), opts...)
+	if errors.Is(err, ErrMissingAPIKey) {
+		return nil, fmt.Errorf("%w: environment variable %s is not set", ErrMissingAPIKey, envKey)
+	}
+	return v, err
+}
@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFormat is wrapped by errors for codes rejected locally because they
+// are structurally malformed, e.g. a country code that isn't two ASCII letters.
+var ErrInvalidFormat = errors.New("countriesdb: invalid code format")
+
+// ErrInvalidCode is wrapped by errors reporting that the API considered a code invalid.
+var ErrInvalidCode = errors.New("countriesdb: invalid code")
+
+// ErrMissingAPIKey is returned by NewValidator and NewValidatorFromEnv when no API key is given.
+var ErrMissingAPIKey = errors.New("countriesdb: api key is required")
+
+// ErrInvalidAPIKey is wrapped by the error NewValidator returns for an API key
+// that is certainly malformed (see WithAPIKeyCheck).
+var ErrInvalidAPIKey = errors.New("countriesdb: invalid api key")
+
+// ErrResponseTooLarge is returned when a response body exceeds the limit set by
+// WithMaxResponseBodySize.
+var ErrResponseTooLarge = errors.New("countriesdb: response body too large")
+
+const (
+	// errorBodyLimit caps how much of an error response is read.
+	errorBodyLimit = 64 << 10
+	// errorSnippetLimit caps how much of a non-JSON error body is kept in APIError.Body.
+	errorSnippetLimit = 512
+)
+
+// APIError is returned when the CountriesDB API (or a proxy in front of it)
+// responds with an HTTP error status.
+type APIError struct {
+	StatusCode int
+	// Message is the message from the API's JSON error body, if any.
+	Message string
+	// Body is a truncated snippet of the raw response body when it isn't a
+	// JSON error, e.g. an HTML page from a gateway.
+	Body string
+	// RetryAfter is the delay requested by the response's Retry-After header
+	// (typically on a 429), or zero if there was none.
+	RetryAfter time.Duration
+	// RequestID identifies the failed request for support: the X-Request-Id
+	// echoed by the server, or else the one sent.
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	switch {
+	case e.Message != "":
+		return e.Message
+	case e.Body != "":
+		return fmt.Sprintf("countriesdb: http %d: %s", e.StatusCode, e.Body)
+	default:
+		return fmt.Sprintf("countriesdb: http %d", e.StatusCode)
+	}
+}
+
+// ErrAPI matches any *APIError with errors.Is, e.g. errors.Is(err, ErrAPI).
+var ErrAPI = &APIError{}
+
+// Is reports whether e matches target for errors.Is: ErrAPI, or any *APIError
+// with a zero StatusCode, matches every APIError; an *APIError with a non-zero
+// StatusCode matches APIErrors with that status, so that
+// errors.Is(err, &APIError{StatusCode: 429}) reports rate limiting.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return t.StatusCode == 0 || t.StatusCode == e.StatusCode
+}
+
+// IsNetworkError reports whether err is a transport-level failure, such as a
+// *url.Error, a *net.OpError, a DNS error, a timeout or a response cut off
+// mid-read, as opposed to an answer from the API. Cancellation of the caller's
+// context is not a network error. A deadline on the caller's context is, since
+// the error looks the same as a WithTimeout timeout; check ctx.Err() first to
+// handle it separately.
+func IsNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// IsAPIError reports whether err is an *APIError, i.e. the API (or a proxy in
+// front of it) responded with an HTTP error status.
+func IsAPIError(err error) bool {
+	return errors.Is(err, ErrAPI)
+}
+
+// IsValidationError reports whether err rejects the input itself: a code that
+// is malformed (ErrInvalidFormat) or, with WithFailOnInvalid, reported invalid
+// (ErrInvalidCode).
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrInvalidFormat) || errors.Is(err, ErrInvalidCode)
+}
+
+// newAPIError builds an APIError from an error response.
+func newAPIError(resp *http.Response, body io.Reader) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode, RequestID: responseRequestID(resp)}
+	apiErr.RetryAfter, _ = retryAfter(resp.Header)
+
+	data, _ := io.ReadAll(io.LimitReader(body, errorBodyLimit))
+
+	var decoded apiError
+	if err := json.Unmarshal(data, &decoded); err == nil && decoded.Message != "" {
+		apiErr.Message = decoded.Message
+		return apiErr
+	}
+
+	apiErr.Body = snippet(data, errorSnippetLimit)
+	return apiErr
+}
+
+const requestIDHeader = "X-Request-Id"
+
+// responseRequestID returns the request ID echoed in resp, falling back to the
+// one sent with its request.
+func responseRequestID(resp *http.Response) string {
+	if id := resp.Header.Get(requestIDHeader); id != "" {
+		return id
+	}
+	if resp.Request != nil {
+		return resp.Request.Header.Get(requestIDHeader)
+	}
+	return ""
+}
+
+// snippet returns up to limit bytes of data as trimmed, valid UTF-8.
+func snippet(data []byte, limit int) string {
+	truncated := len(data) > limit
+	if truncated {
+		data = data[:limit]
+	}
+
+	s := strings.ToValidUTF8(strings.TrimSpace(string(data)), "")
+	if truncated {
+		s += "..."
+	}
+	return s
+}
+
+// limitedReader reads from r until remaining bytes have been read, then fails
+// with ErrResponseTooLarge rather than silently truncating like io.LimitReader.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for one more byte to tell an exactly-full body from an oversized one.
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// snippetWriter keeps the first limit bytes written to it.
+type snippetWriter struct {
+	buf   []byte
+	limit int
+}
+
+func (w *snippetWriter) Write(p []byte) (int, error) {
+	if room := w.limit + 1 - len(w.buf); room > 0 {
+		if len(p) < room {
+			room = len(p)
+		}
+		w.buf = append(w.buf, p[:room]...)
+	}
+	return len(p), nil
+}
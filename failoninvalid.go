@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WithFailOnInvalid makes ValidateCountry, ValidateSubdivision and the methods
+// built on them return an error wrapping ErrInvalidCode, with the code and the
+// API's message, for codes reported invalid, alongside the result. Batch methods
+// such as ValidateCountries still return every result, together with a single
+// error joining one such error per invalid code (see errors.Join).
+// CountryExistenceMap is unaffected, as are the validators of timezones, TLDs,
+// postal codes, IBANs, VAT numbers, payment methods and shipping.
+func WithFailOnInvalid() Option {
+	return func(v *Validator) {
+		v.failOnInvalid = true
+	}
+}
+
+// invalidError returns the error for result of code under WithFailOnInvalid, or
+// nil if the result is valid or the option isn't set.
+func (v *Validator) invalidError(code string, result ValidationResult) error {
+	if !v.failOnInvalid || result.Valid {
+		return nil
+	}
+	return fmt.Errorf("%w %q: %s", ErrInvalidCode, code, result.Message)
+}
+
+// invalidErrors joins the errors of invalidError for results of codes.
+func (v *Validator) invalidErrors(codes []string, results []ValidationResult) error {
+	if !v.failOnInvalid {
+		return nil
+	}
+
+	var errs []error
+	for i, result := range results {
+		if err := v.invalidError(codes[i], result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
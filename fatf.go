@@ -0,0 +1,26 @@
+package validator
+
+// FATFListAsOf is the FATF plenary date of the bundled blacklist and greylist.
+// The lists change several times a year; update the module to pick up new revisions.
+const FATFListAsOf = "2025-06-13"
+
+// fatfBlacklist holds the FATF "high-risk jurisdictions subject to a call for action".
+var fatfBlacklist = newCodeSet("IR", "KP", "MM")
+
+// fatfGreylist holds the FATF "jurisdictions under increased monitoring".
+var fatfGreylist = newCodeSet(
+	"AO", "BF", "BG", "BO", "CD", "CI", "CM", "DZ", "HT", "KE", "LA", "LB",
+	"MC", "MZ", "NA", "NG", "NP", "SS", "SY", "VE", "VG", "VN", "YE", "ZA",
+)
+
+// IsOnFATFBlacklist reports whether alpha2 is a FATF high-risk jurisdiction subject
+// to a call for action (as of FATFListAsOf).
+func IsOnFATFBlacklist(alpha2 string) bool {
+	return inCodeSet(fatfBlacklist, alpha2)
+}
+
+// IsOnFATFGreylist reports whether alpha2 is a FATF jurisdiction under increased
+// monitoring (as of FATFListAsOf).
+func IsOnFATFGreylist(alpha2 string) bool {
+	return inCodeSet(fatfGreylist, alpha2)
+}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubdivisionInfo describes a subdivision in the subdivision hierarchy.
+type SubdivisionInfo struct {
+	Code  string           `json:"code"`
+	Name  string           `json:"name,omitempty"`
+	Level SubdivisionLevel `json:"level,omitempty"`
+	// ParentCode is the code of the parent subdivision, or empty for a
+	// top-level subdivision.
+	ParentCode string `json:"parent_code,omitempty"`
+}
+
+// ParentSubdivision returns the parent of a subdivision of country, e.g. the
+// state of a US county. For a top-level subdivision it returns a SubdivisionInfo
+// with an empty Code. Malformed codes fail with an error wrapping
+// ErrInvalidFormat without calling the API; unknown ones fail with the API's
+// *APIError.
+func (v *Validator) ParentSubdivision(ctx context.Context, code, country string) (SubdivisionInfo, error) {
+	payload, err := hierarchyPayload(code, country)
+	if err != nil {
+		return SubdivisionInfo{}, err
+	}
+
+	var parent SubdivisionInfo
+	err = v.post(ctx, "/api/subdivision/parent", payload, &parent)
+	return parent, err
+}
+
+// ChildSubdivisions returns the direct children of a subdivision of country,
+// e.g. the counties of a US state, or an empty slice if it has none. Malformed
+// codes fail with an error wrapping ErrInvalidFormat without calling the API;
+// unknown ones fail with the API's *APIError.
+func (v *Validator) ChildSubdivisions(ctx context.Context, code, country string) ([]SubdivisionInfo, error) {
+	payload, err := hierarchyPayload(code, country)
+	if err != nil {
+		return nil, err
+	}
+
+	var children struct {
+		Results []SubdivisionInfo `json:"results"`
+	}
+	if err := v.post(ctx, "/api/subdivision/children", payload, &children); err != nil {
+		return nil, err
+	}
+
+	if children.Results == nil {
+		return []SubdivisionInfo{}, nil
+	}
+	return children.Results, nil
+}
+
+// hierarchyPayload checks and normalizes the arguments of the hierarchy lookups.
+func hierarchyPayload(code, country string) (map[string]any, error) {
+	upperCountry, ok := countryParam(country)
+	if !ok {
+		return nil, fmt.Errorf("%w: country code %q must be two ASCII letters", ErrInvalidFormat, country)
+	}
+
+	normalized, ok := normalizeSubdivisionCode(code)
+	if !ok || !IsValidSubdivisionCodeFormat(normalized, upperCountry) {
+		return nil, fmt.Errorf("%w: %q is not a subdivision code of %s", ErrInvalidFormat, code, upperCountry)
+	}
+
+	return map[string]any{
+		"code":    normalized,
+		"country": upperCountry,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// TimingInfo breaks down the duration of one HTTP request attempt. Phases that
+// didn't happen, such as DNS, Connect and TLS on a reused connection, are zero.
+type TimingInfo struct {
+	// Path is the API path of the request, e.g. "/api/validate/country".
+	Path string
+	// DNS is the time spent resolving the host name.
+	DNS time.Duration
+	// Connect is the time spent establishing the TCP connection.
+	Connect time.Duration
+	// TLS is the time spent on the TLS handshake.
+	TLS time.Duration
+	// FirstByte is the time from the start of the request until the first
+	// response byte, i.e. including the phases above and the backend's work.
+	FirstByte time.Duration
+	// Total is the time from the start of the request until the response body
+	// was closed, or until the request failed.
+	Total time.Duration
+	// ReusedConn reports whether an idle pooled connection was used.
+	ReusedConn bool
+	// Err is the error the request failed with, if any.
+	Err error
+}
+
+// WithHTTPTrace calls fn with the timing of every request attempt (retries are
+// reported separately), e.g. to feed latency histograms per phase. fn may be
+// called concurrently and should return quickly. Tracing is off by default.
+func WithHTTPTrace(fn func(TimingInfo)) Option {
+	return func(v *Validator) {
+		v.httpTrace = fn
+	}
+}
+
+// requestTrace collects the TimingInfo of one request attempt.
+type requestTrace struct {
+	mu    sync.Mutex
+	start time.Time
+	info  TimingInfo
+
+	dnsStart, tlsStart time.Time
+	connectStarts      map[string]time.Time // by address; dials may run in parallel
+
+	once   sync.Once
+	report func(TimingInfo)
+}
+
+// startTrace returns ctx instrumented with an httptrace.ClientTrace for a request to path.
+func (v *Validator) startTrace(ctx context.Context, path string) (context.Context, *requestTrace) {
+	t := &requestTrace{
+		start:         time.Now(),
+		info:          TimingInfo{Path: path},
+		connectStarts: make(map[string]time.Time),
+		report:        v.httpTrace,
+	}
+
+	trace := &httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { t.mark(&t.dnsStart) },
+		DNSDone:           func(httptrace.DNSDoneInfo) { t.since(&t.dnsStart, &t.info.DNS) },
+		ConnectStart:      t.connectStart,
+		ConnectDone:       t.connectDone,
+		TLSHandshakeStart: func() { t.mark(&t.tlsStart) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { t.since(&t.tlsStart, &t.info.TLS) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.info.ReusedConn = info.Reused
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() { t.since(&t.start, &t.info.FirstByte) },
+	}
+
+	return httptrace.WithClientTrace(ctx, trace), t
+}
+
+func (t *requestTrace) mark(at *time.Time) {
+	t.mu.Lock()
+	*at = time.Now()
+	t.mu.Unlock()
+}
+
+// since sets d to the time elapsed since *start, reading it under the lock
+// because hooks may run on different goroutines.
+func (t *requestTrace) since(start *time.Time, d *time.Duration) {
+	t.mu.Lock()
+	*d = time.Since(*start)
+	t.mu.Unlock()
+}
+
+func (t *requestTrace) connectStart(network, addr string) {
+	t.mu.Lock()
+	t.connectStarts[addr] = time.Now()
+	t.mu.Unlock()
+}
+
+// connectDone records the duration of the dial to addr. With parallel dials
+// (happy eyeballs) the one that succeeded wins over failed ones.
+func (t *requestTrace) connectDone(network, addr string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start, ok := t.connectStarts[addr]
+	if !ok || (err != nil && t.info.Connect != 0) {
+		return
+	}
+	t.info.Connect = time.Since(start)
+}
+
+// finish reports the timing once, with Total measured up to now.
+func (t *requestTrace) finish(err error) {
+	t.once.Do(func() {
+		t.mu.Lock()
+		info := t.info
+		t.mu.Unlock()
+
+		info.Total = time.Since(t.start)
+		info.Err = err
+		t.report(info)
+	})
+}
+
+// tracedBody finishes its trace when the response body is closed.
+type tracedBody struct {
+	io.ReadCloser
+	trace *requestTrace
+}
+
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.trace.finish(nil)
+	return err
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// WithIBANLookup makes ValidateIBAN confirm the country of IBANs that pass the
+// local checks with ValidateCountry, at the cost of an API request. Without it,
+// ValidateIBAN runs entirely offline.
+func WithIBANLookup() Option {
+	return func(v *Validator) {
+		v.ibanLookup = true
+	}
+}
+
+// ValidateIBAN validates that iban is well formed, passes the ISO 13616 mod-97
+// checksum and belongs to expectedCountry. The checks run locally; with
+// WithIBANLookup, the IBAN's country is also confirmed with ValidateCountry.
+func (v *Validator) ValidateIBAN(ctx context.Context, iban string, expectedCountry string) (ValidationResult, error) {
+	expectedCountry, ok := countryParam(expectedCountry)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	iban = normalizeIBAN(iban)
+	if !isValidIBANFormat(iban) {
+		return ValidationResult{Valid: false, Message: "Invalid IBAN format."}, nil
+	}
+
+	if !ibanChecksumValid(iban) {
+		return ValidationResult{Valid: false, Message: "Invalid IBAN checksum."}, nil
+	}
+
+	country := iban[:2]
+	if country != expectedCountry {
+		return ValidationResult{Valid: false, Message: "IBAN country " + country + " does not match " + expectedCountry + "."}, nil
+	}
+
+	if !v.ibanLookup {
+		return ValidationResult{Valid: true, Code: country}, nil
+	}
+	return v.validateCountry(ctx, country, CountryOptions{})
+}
+
+// normalizeIBAN strips spaces and uppercases iban.
+func normalizeIBAN(iban string) string {
+	upper, _ := asciiUpper(strings.ReplaceAll(strings.TrimSpace(iban), " ", ""))
+	return upper
+}
+
+// isValidIBANFormat checks the country prefix, check digits, length and character set.
+func isValidIBANFormat(iban string) bool {
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	for i, r := range iban {
+		switch {
+		case i < 2:
+			if r < 'A' || r > 'Z' {
+				return false
+			}
+		case i < 4:
+			if r < '0' || r > '9' {
+				return false
+			}
+		default:
+			if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ibanChecksumValid performs the ISO 13616 mod-97 check on a well-formed IBAN.
+func ibanChecksumValid(iban string) bool {
+	rearranged := iban[4:] + iban[:4]
+
+	remainder := 0
+	for _, r := range rearranged {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+
+	return remainder == 1
+}
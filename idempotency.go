@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context whose validation requests carry a key
+// derived from key in the Idempotency-Key header: key, a hyphen and a hash of
+// the request, so the chunks of a batch call get distinct keys while the same
+// request always gets the same one. It is sent unchanged on every retry.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// WithAutoIdempotencyKey sends a new random UUID in the Idempotency-Key header of
+// every call that has no key set with WithIdempotencyKey. The same key is sent on
+// every retry of the call, so gateways can deduplicate retried requests.
+func WithAutoIdempotencyKey() Option {
+	return func(v *Validator) {
+		v.autoIdempotencyKey = true
+	}
+}
+
+// idempotencyKey returns the key for a request of body to path: one derived
+// from the key in ctx, a new UUID if WithAutoIdempotencyKey is set, or "" for none.
+func (v *Validator) idempotencyKey(ctx context.Context, path string, body []byte) string {
+	if key, ok := ctx.Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		sum := sha256.Sum256(append([]byte(path+"\x00"), body...))
+		return key + "-" + hex.EncodeToString(sum[:8])
+	}
+
+	if v.autoIdempotencyKey {
+		return newUUID()
+	}
+
+	return ""
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,29 @@
+package validator
+
+// LandlockedListAsOf is the date on which the bundled landlocked country list was last verified.
+const LandlockedListAsOf = "2011-07-14"
+
+// landlocked lists the countries without a sea coast. Azerbaijan, Kazakhstan and
+// Turkmenistan only border the Caspian Sea, which has no sea access, and count
+// as landlocked.
+var landlocked = newCodeSet(
+	"AD", "AF", "AM", "AT", "AZ", "BF", "BI", "BO", "BT", "BW", "BY", "CF", "CH", "CZ",
+	"ET", "HU", "KG", "KZ", "LA", "LI", "LS", "LU", "MD", "MK", "ML", "MN", "MW", "NE",
+	"NP", "PY", "RS", "RW", "SK", "SM", "SS", "SZ", "TD", "TJ", "TM", "UG", "UZ", "VA",
+	"ZM", "ZW",
+)
+
+// doublyLandlocked lists the countries surrounded only by landlocked countries.
+var doublyLandlocked = newCodeSet("LI", "UZ")
+
+// IsLandlocked reports whether alpha2 is a landlocked country, i.e. has no sea
+// coast (as of LandlockedListAsOf).
+func IsLandlocked(alpha2 string) bool {
+	return inCodeSet(landlocked, alpha2)
+}
+
+// IsDoublyLandlocked reports whether alpha2 is surrounded only by landlocked
+// countries, i.e. Liechtenstein or Uzbekistan (as of LandlockedListAsOf).
+func IsDoublyLandlocked(alpha2 string) bool {
+	return inCodeSet(doublyLandlocked, alpha2)
+}
@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubdivisionLevel is the administrative level of a subdivision, as reported by
+// the API in ValidationResult.Level.
+type SubdivisionLevel string
+
+// Subdivision levels.
+const (
+	LevelState        SubdivisionLevel = "state"
+	LevelProvince     SubdivisionLevel = "province"
+	LevelRegion       SubdivisionLevel = "region"
+	LevelDepartment   SubdivisionLevel = "department"
+	LevelCounty       SubdivisionLevel = "county"
+	LevelDistrict     SubdivisionLevel = "district"
+	LevelMunicipality SubdivisionLevel = "municipality"
+	LevelTerritory    SubdivisionLevel = "territory"
+)
+
+// ValidateSubdivisionLevel validates a single subdivision of country like
+// ValidateSubdivision with default options, and additionally reports it as
+// invalid unless its administrative level is level, e.g. to accept only states
+// and not counties. A subdivision whose level the API doesn't report is invalid.
+func (v *Validator) ValidateSubdivisionLevel(ctx context.Context, code, country string, level SubdivisionLevel) (ValidationResult, error) {
+	result, err := v.validateSubdivision(ctx, code, country, SubdivisionOptions{})
+	if err != nil {
+		return result, err
+	}
+
+	if result.Valid && result.Level != level {
+		result.Valid = false
+		if result.Level == "" {
+			result.Message = fmt.Sprintf("Level of subdivision %s is unknown, expected %s.", result.Code, level)
+		} else {
+			result.Message = fmt.Sprintf("Subdivision %s is a %s, not a %s.", result.Code, result.Level, level)
+		}
+	}
+
+	return result, v.invalidError(code, result)
+}
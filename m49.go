@@ -0,0 +1,160 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// M49ListAsOf is the date on which the bundled UN M.49 region assignments were last verified.
+const M49ListAsOf = "2024-01-01"
+
+// m49Areas assigns countries to UN M.49 areas as {region, sub-region,
+// intermediate region (0 if none), countries}. Antarctica (AQ) belongs to no
+// region; Taiwan (TW) isn't listed by M.49 and is placed in Eastern Asia.
+var m49Areas = []struct {
+	region, subRegion, intermediateRegion int
+	countries                             []string
+}{
+	{2, 15, 0, []string{ // Northern Africa
+		"DZ", "EG", "EH", "LY", "MA", "SD", "TN",
+	}},
+	{2, 202, 14, []string{ // Eastern Africa
+		"BI", "DJ", "ER", "ET", "IO", "KE", "KM", "MG", "MU", "MW", "MZ", "RE", "RW", "SC",
+		"SO", "SS", "TF", "TZ", "UG", "YT", "ZM", "ZW",
+	}},
+	{2, 202, 17, []string{ // Middle Africa
+		"AO", "CD", "CF", "CG", "CM", "GA", "GQ", "ST", "TD",
+	}},
+	{2, 202, 18, []string{ // Southern Africa
+		"BW", "LS", "NA", "SZ", "ZA",
+	}},
+	{2, 202, 11, []string{ // Western Africa
+		"BF", "BJ", "CI", "CV", "GH", "GM", "GN", "GW", "LR", "ML", "MR", "NE", "NG", "SH",
+		"SL", "SN", "TG",
+	}},
+	{19, 419, 29, []string{ // Caribbean
+		"AG", "AI", "AW", "BB", "BL", "BQ", "BS", "CU", "CW", "DM", "DO", "GD", "GP", "HT",
+		"JM", "KN", "KY", "LC", "MF", "MQ", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI",
+	}},
+	{19, 419, 13, []string{ // Central America
+		"BZ", "CR", "GT", "HN", "MX", "NI", "PA", "SV",
+	}},
+	{19, 419, 5, []string{ // South America
+		"AR", "BO", "BR", "BV", "CL", "CO", "EC", "FK", "GF", "GS", "GY", "PE", "PY", "SR",
+		"UY", "VE",
+	}},
+	{19, 21, 0, []string{ // Northern America
+		"BM", "CA", "GL", "PM", "US",
+	}},
+	{142, 143, 0, []string{ // Central Asia
+		"KG", "KZ", "TJ", "TM", "UZ",
+	}},
+	{142, 30, 0, []string{ // Eastern Asia
+		"CN", "HK", "JP", "KP", "KR", "MN", "MO", "TW",
+	}},
+	{142, 35, 0, []string{ // South-eastern Asia
+		"BN", "ID", "KH", "LA", "MM", "MY", "PH", "SG", "TH", "TL", "VN",
+	}},
+	{142, 34, 0, []string{ // Southern Asia
+		"AF", "BD", "BT", "IN", "IR", "LK", "MV", "NP", "PK",
+	}},
+	{142, 145, 0, []string{ // Western Asia
+		"AE", "AM", "AZ", "BH", "CY", "GE", "IL", "IQ", "JO", "KW", "LB", "OM", "PS", "QA",
+		"SA", "SY", "TR", "YE",
+	}},
+	{150, 151, 0, []string{ // Eastern Europe
+		"BG", "BY", "CZ", "HU", "MD", "PL", "RO", "RU", "SK", "UA",
+	}},
+	{150, 154, 0, []string{ // Northern Europe
+		"AX", "DK", "EE", "FI", "FO", "GB", "IE", "IM", "IS", "LT", "LV", "NO", "SE", "SJ",
+	}},
+	{150, 154, 830, []string{ // Channel Islands
+		"GG", "JE",
+	}},
+	{150, 39, 0, []string{ // Southern Europe
+		"AD", "AL", "BA", "ES", "GI", "GR", "HR", "IT", "ME", "MK", "MT", "PT", "RS", "SI",
+		"SM", "VA",
+	}},
+	{150, 155, 0, []string{ // Western Europe
+		"AT", "BE", "CH", "DE", "FR", "LI", "LU", "MC", "NL",
+	}},
+	{9, 53, 0, []string{ // Australia and New Zealand
+		"AU", "CC", "CX", "HM", "NF", "NZ",
+	}},
+	{9, 54, 0, []string{ // Melanesia
+		"FJ", "NC", "PG", "SB", "VU",
+	}},
+	{9, 57, 0, []string{ // Micronesia
+		"FM", "GU", "KI", "MH", "MP", "NR", "PW", "UM",
+	}},
+	{9, 61, 0, []string{ // Polynesia
+		"AS", "CK", "NU", "PF", "PN", "TK", "TO", "TV", "WF", "WS",
+	}},
+}
+
+type m49Codes struct {
+	region, subRegion, intermediateRegion int
+}
+
+var countryM49 = func() map[string]m49Codes {
+	m := make(map[string]m49Codes)
+	for _, area := range m49Areas {
+		for _, code := range area.countries {
+			m[code] = m49Codes{area.region, area.subRegion, area.intermediateRegion}
+		}
+	}
+	return m
+}()
+
+// lookupM49 returns the M.49 codes of alpha2, or an error wrapping
+// ErrUnknownCountry if it isn't an assigned code.
+func lookupM49(alpha2 string) (m49Codes, error) {
+	code := normalizeAlpha2(alpha2)
+	if _, err := lookupCountry(code); err != nil {
+		return m49Codes{}, fmt.Errorf("%w: %q", ErrUnknownCountry, alpha2)
+	}
+	return countryM49[code], nil
+}
+
+// RegionOf returns the UN M.49 region code of alpha2, e.g. 150 (Europe) for
+// "FR", or 0 for Antarctica, which belongs to none. It returns an error wrapping
+// ErrUnknownCountry for codes that are not assigned ISO 3166-1 codes. Runs offline.
+func RegionOf(alpha2 string) (int, error) {
+	codes, err := lookupM49(alpha2)
+	return codes.region, err
+}
+
+// SubRegionOf returns the UN M.49 sub-region code of alpha2, e.g. 155 (Western
+// Europe) for "FR", or 0 for Antarctica. Errors are as for RegionOf.
+func SubRegionOf(alpha2 string) (int, error) {
+	codes, err := lookupM49(alpha2)
+	return codes.subRegion, err
+}
+
+// IntermediateRegionOf returns the UN M.49 intermediate region code of alpha2,
+// e.g. 29 (Caribbean) for "JM", or 0 if its sub-region isn't divided further.
+// Errors are as for RegionOf.
+func IntermediateRegionOf(alpha2 string) (int, error) {
+	codes, err := lookupM49(alpha2)
+	return codes.intermediateRegion, err
+}
+
+// CountriesInRegion returns the alpha-2 codes of the countries in the UN M.49
+// region, sub-region or intermediate region regionCode (e.g. 150 for Europe, 154
+// for Northern Europe), sorted alphabetically. It returns an error if regionCode
+// isn't an M.49 area code covering any country. Runs offline.
+func CountriesInRegion(regionCode int) ([]string, error) {
+	var codes []string
+	for _, area := range m49Areas {
+		if area.region == regionCode || area.subRegion == regionCode || (area.intermediateRegion != 0 && area.intermediateRegion == regionCode) {
+			codes = append(codes, area.countries...)
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("countriesdb: unknown M.49 region %d", regionCode)
+	}
+
+	sort.Strings(codes)
+	return codes, nil
+}
@@ -0,0 +1,68 @@
+package validator
+
+// Dates on which the bundled membership lists were last verified.
+const (
+	EUMemberListAsOf  = "2020-02-01"
+	EEAMemberListAsOf = "2020-02-01"
+	UNMemberListAsOf  = "2011-07-14"
+)
+
+var euMembers = newCodeSet(
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+)
+
+var eeaMembers = newCodeSet(append(codeSetKeys(euMembers), "IS", "LI", "NO")...)
+
+var unMembers = newCodeSet(
+	"AD", "AE", "AF", "AG", "AL", "AM", "AO", "AR", "AT", "AU", "AZ", "BA", "BB", "BD",
+	"BE", "BF", "BG", "BH", "BI", "BJ", "BN", "BO", "BR", "BS", "BT", "BW", "BY", "BZ",
+	"CA", "CD", "CF", "CG", "CH", "CI", "CL", "CM", "CN", "CO", "CR", "CU", "CV", "CY",
+	"CZ", "DE", "DJ", "DK", "DM", "DO", "DZ", "EC", "EE", "EG", "ER", "ES", "ET", "FI",
+	"FJ", "FM", "FR", "GA", "GB", "GD", "GE", "GH", "GM", "GN", "GQ", "GR", "GT", "GW",
+	"GY", "HN", "HR", "HT", "HU", "ID", "IE", "IL", "IN", "IQ", "IR", "IS", "IT", "JM",
+	"JO", "JP", "KE", "KG", "KH", "KI", "KM", "KN", "KP", "KR", "KW", "KZ", "LA", "LB",
+	"LC", "LI", "LK", "LR", "LS", "LT", "LU", "LV", "LY", "MA", "MC", "MD", "ME", "MG",
+	"MH", "MK", "ML", "MM", "MN", "MR", "MT", "MU", "MV", "MW", "MX", "MY", "MZ", "NA",
+	"NE", "NG", "NI", "NL", "NO", "NP", "NR", "NZ", "OM", "PA", "PE", "PG", "PH", "PK",
+	"PL", "PT", "PW", "PY", "QA", "RO", "RS", "RU", "RW", "SA", "SB", "SC", "SD", "SE",
+	"SG", "SI", "SK", "SL", "SM", "SN", "SO", "SR", "SS", "ST", "SV", "SY", "SZ", "TD",
+	"TG", "TH", "TJ", "TL", "TM", "TN", "TO", "TR", "TT", "TV", "TZ", "UA", "UG", "US",
+	"UY", "UZ", "VC", "VE", "VN", "VU", "WS", "YE", "ZA", "ZM", "ZW",
+)
+
+// IsEUMember reports whether alpha2 is a member state of the European Union (as of EUMemberListAsOf).
+func IsEUMember(alpha2 string) bool {
+	return inCodeSet(euMembers, alpha2)
+}
+
+// IsEEAMember reports whether alpha2 is a member of the European Economic Area (as of EEAMemberListAsOf).
+func IsEEAMember(alpha2 string) bool {
+	return inCodeSet(eeaMembers, alpha2)
+}
+
+// IsUNMember reports whether alpha2 is a member state of the United Nations (as of UNMemberListAsOf).
+func IsUNMember(alpha2 string) bool {
+	return inCodeSet(unMembers, alpha2)
+}
+
+func newCodeSet(codes ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		set[code] = struct{}{}
+	}
+	return set
+}
+
+func codeSetKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for code := range set {
+		keys = append(keys, code)
+	}
+	return keys
+}
+
+func inCodeSet(set map[string]struct{}, code string) bool {
+	_, ok := set[normalizeAlpha2(code)]
+	return ok
+}
@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CallMeta describes how a validation call was carried out.
+type CallMeta struct {
+	// Duration is the wall-clock time of the whole call.
+	Duration time.Duration
+	// Attempts is the number of HTTP requests sent; 0 if the call was resolved locally.
+	Attempts int
+	// StatusCode is the HTTP status of the last response, or 0 if none was received.
+	StatusCode int
+	// RequestID identifies the last request for support: the X-Request-Id echoed
+	// by the server, or else the one sent.
+	RequestID string
+}
+
+type callMetaKey struct{}
+
+// callMetaRecorder collects CallMeta from every request made on behalf of one call.
+type callMetaRecorder struct {
+	mu   sync.Mutex
+	meta CallMeta
+}
+
+// withCallMeta returns a context whose requests are recorded in the returned recorder.
+func withCallMeta(ctx context.Context) (context.Context, *callMetaRecorder) {
+	rec := &callMetaRecorder{}
+	return context.WithValue(ctx, callMetaKey{}, rec), rec
+}
+
+// recordAttempt notes one HTTP request, its status code (0 if it failed) and its
+// request ID in the call's recorder, if any.
+func recordAttempt(ctx context.Context, statusCode int, requestID string) {
+	rec, ok := ctx.Value(callMetaKey{}).(*callMetaRecorder)
+	if !ok {
+		return
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.meta.Attempts++
+	rec.meta.StatusCode = statusCode
+	rec.meta.RequestID = requestID
+}
+
+// finish returns the recorded metadata with the duration since start.
+func (rec *callMetaRecorder) finish(start time.Time) CallMeta {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	meta := rec.meta
+	meta.Duration = time.Since(start)
+	return meta
+}
+
+// ValidateCountryWithMeta is like ValidateCountry but also reports how the call was carried out.
+func (v *Validator) ValidateCountryWithMeta(ctx context.Context, code string, opts CountryOptions) (ValidationResult, CallMeta, error) {
+	start := time.Now()
+	ctx, rec := withCallMeta(ctx)
+	result, err := v.ValidateCountry(ctx, code, opts)
+	return result, rec.finish(start), err
+}
+
+// ValidateSubdivisionWithMeta is like ValidateSubdivision but also reports how the call was carried out.
+func (v *Validator) ValidateSubdivisionWithMeta(ctx context.Context, code string, country string, opts SubdivisionOptions) (ValidationResult, CallMeta, error) {
+	start := time.Now()
+	ctx, rec := withCallMeta(ctx)
+	result, err := v.ValidateSubdivision(ctx, code, country, opts)
+	return result, rec.finish(start), err
+}
+
+// ValidateCountriesWithMeta is like ValidateCountries but also reports how the
+// call was carried out. StatusCode tells apart success statuses such as 200 and
+// 207 (Multi-Status), should the API report partial results.
+func (v *Validator) ValidateCountriesWithMeta(ctx context.Context, codes []string, opts CountryOptions) ([]ValidationResult, CallMeta, error) {
+	start := time.Now()
+	ctx, rec := withCallMeta(ctx)
+	results, err := v.ValidateCountries(ctx, codes, opts)
+	return results, rec.finish(start), err
+}
+
+// ValidateSubdivisionsWithMeta is like ValidateSubdivisions but also reports how
+// the call was carried out. When the codes are sent individually (FollowRelated),
+// StatusCode is that of the last response received.
+func (v *Validator) ValidateSubdivisionsWithMeta(ctx context.Context, codes []string, country string, opts SubdivisionOptions) ([]ValidationResult, CallMeta, error) {
+	start := time.Now()
+	ctx, rec := withCallMeta(ctx)
+	results, err := v.ValidateSubdivisions(ctx, codes, country, opts)
+	return results, rec.finish(start), err
+}
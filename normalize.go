@@ -0,0 +1,235 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	nonASCIICountryMessage     = "Country code must contain only ASCII characters."
+	nonASCIISubdivisionMessage = "Subdivision code must contain only ASCII characters."
+)
+
+// asciiUpper uppercases the ASCII letters of s. Unlike strings.ToUpper it never
+// folds Unicode lookalikes (e.g. "ı" or "ß") into ASCII letters: ok is false if
+// s contains any non-ASCII character.
+func asciiUpper(s string) (upper string, ok bool) {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 0x80 {
+			return "", false
+		}
+		if c >= 'a' && c <= 'z' {
+			b[i] = c - ('a' - 'A')
+		}
+	}
+	return string(b), true
+}
+
+// IsValidCountryCodeFormat reports whether code is structurally an ISO 3166-1
+// alpha-2 code, i.e. two ASCII letters in any case. It does not check that the
+// code is assigned.
+func IsValidCountryCodeFormat(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		c := code[i] | 0x20 // ASCII lowercase
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidSubdivisionCodeFormat reports whether code is structurally an ISO 3166-2
+// subdivision code of country, in any case: either the full form such as "US-CA"
+// with country's prefix, or the bare one to three letters or digits after the
+// prefix, such as "CA". country must be two ASCII letters. It does not check that
+// the code is assigned, and does not trim whitespace.
+func IsValidSubdivisionCodeFormat(code, country string) bool {
+	if !IsValidCountryCodeFormat(country) {
+		return false
+	}
+
+	upper, ok := asciiUpper(code)
+	if !ok {
+		return false
+	}
+
+	if prefix, rest, found := strings.Cut(upper, "-"); found {
+		if prefix != strings.ToUpper(country) {
+			return false
+		}
+		upper = rest
+	}
+
+	return isSubdivisionSuffix(upper)
+}
+
+// countryParam normalizes a country argument; ok is false unless it is two ASCII characters.
+func countryParam(country string) (string, bool) {
+	upper, ok := asciiUpper(country)
+	return upper, ok && len(upper) == 2
+}
+
+// normalizeAlpha2 trims and uppercases code for offline lookups, returning ""
+// if it contains non-ASCII characters so that it never matches.
+func normalizeAlpha2(code string) string {
+	upper, _ := asciiUpper(strings.TrimSpace(code))
+	return upper
+}
+
+// normalizeCountryCode trims and uppercases a country code before it is sent.
+func normalizeCountryCode(code string) (string, bool) {
+	return asciiUpper(strings.TrimSpace(code))
+}
+
+// normalizeSubdivisionCode trims and uppercases a subdivision code the same way
+// as normalizeCountryCode.
+func normalizeSubdivisionCode(code string) (string, bool) {
+	return asciiUpper(strings.TrimSpace(code))
+}
+
+// countryAliases maps common non-ISO codes to their ISO 3166-1 alpha-2 code.
+var countryAliases = map[string]string{
+	"UK": "GB",
+}
+
+// NormalizeCountryCode cleans user-supplied input such as " us ", "u.s." or
+// "U.S.A." into an ISO 3166-1 alpha-2 code: it strips whitespace and dots,
+// uppercases, and maps alpha-3 codes (and the common alias "UK") to alpha-2.
+// Use it before ValidateCountry. Input that isn't two or three ASCII letters
+// yields an error wrapping ErrInvalidFormat; codes that are not assigned yield
+// an error wrapping ErrUnknownCountry.
+func NormalizeCountryCode(raw string) (string, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		if r == '.' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, raw)
+
+	code, ok := asciiUpper(cleaned)
+	if !ok {
+		return "", fmt.Errorf("%w: country code %q must contain only ASCII letters", ErrInvalidFormat, raw)
+	}
+
+	switch len(code) {
+	case 2:
+		if alias, ok := countryAliases[code]; ok {
+			code = alias
+		}
+	case 3:
+		alpha2, ok := alpha3ToAlpha2[code]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", ErrUnknownCountry, raw)
+		}
+		code = alpha2
+	default:
+		return "", fmt.Errorf("%w: country code %q must be two or three letters", ErrInvalidFormat, raw)
+	}
+
+	if !IsValidCountryCodeFormat(code) {
+		return "", fmt.Errorf("%w: country code %q must contain only ASCII letters", ErrInvalidFormat, raw)
+	}
+
+	if _, err := lookupCountry(code); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCountry, raw)
+	}
+
+	return code, nil
+}
+
+// NormalizeSubdivisionCode cleans user-supplied subdivision input such as " ca ",
+// "US-CA" or "California" into the bare ISO 3166-2 subdivision code ("CA") of
+// country: it strips whitespace, uppercases, strips the country prefix from
+// compound codes, and maps common subdivision names to codes using a bundled
+// table. It returns an error wrapping ErrInvalidFormat if the input can't be
+// resolved or its prefix belongs to another country. Runs offline.
+func NormalizeSubdivisionCode(raw string, country string) (string, error) {
+	upperCountry, ok := countryParam(country)
+	if !ok {
+		return "", fmt.Errorf("%w: country code %q must be two ASCII letters", ErrInvalidFormat, country)
+	}
+	country = upperCountry
+
+	trimmed := strings.TrimSpace(raw)
+	if code, ok := subdivisionNames[country][subdivisionNameKey(trimmed)]; ok {
+		return code, nil
+	}
+
+	code, ok := asciiUpper(trimmed)
+	if !ok {
+		return "", fmt.Errorf("%w: subdivision %q is not a known name or code", ErrInvalidFormat, raw)
+	}
+
+	if prefix, rest, found := strings.Cut(code, "-"); found {
+		if prefix != country {
+			return "", fmt.Errorf("%w: subdivision %q does not belong to %s", ErrInvalidFormat, raw, country)
+		}
+		code = rest
+	}
+
+	if !isSubdivisionSuffix(code) {
+		return "", fmt.Errorf("%w: subdivision %q is not a known name or code", ErrInvalidFormat, raw)
+	}
+
+	return code, nil
+}
+
+// subdivisionNameKey lowercases name and collapses dots and runs of whitespace
+// so that "New  York" and "new york" share a key.
+func subdivisionNameKey(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(name, ".", ""))), " ")
+}
+
+// isSubdivisionSuffix reports whether code is a plausible ISO 3166-2 subdivision
+// code without its country prefix: one to three ASCII letters or digits.
+func isSubdivisionSuffix(code string) bool {
+	if len(code) < 1 || len(code) > 3 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// DedupCodes trims and uppercases country codes, drops blank and duplicate ones
+// (so "us" and " US" collapse), and returns the rest in sorted order, e.g. to
+// avoid validating the same code twice in a batch. Codes with non-ASCII
+// characters are kept as given, trimmed, for the API to reject. Runs offline.
+func DedupCodes(codes []string) []string {
+	return dedupSorted(codes)
+}
+
+// DedupSubdivisionCodes is DedupCodes for subdivision codes such as "us-ca".
+func DedupSubdivisionCodes(codes []string) []string {
+	return dedupSorted(codes)
+}
+
+func dedupSorted(codes []string) []string {
+	seen := make(map[string]struct{}, len(codes))
+	deduped := make([]string, 0, len(codes))
+
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if upper, ok := asciiUpper(code); ok {
+			code = upper
+		}
+		if _, dup := seen[code]; dup || code == "" {
+			continue
+		}
+		seen[code] = struct{}{}
+		deduped = append(deduped, code)
+	}
+
+	sort.Strings(deduped)
+	return deduped
+}
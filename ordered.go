@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"context"
+	"sync"
+)
+
+// StreamCountriesInOrder validates multiple country codes in chunks of the
+// configured batch size (see WithBatchSize), run concurrently up to the limit
+// set by WithConcurrency, and passes the results to fn in input order, each as
+// soon as all results before it have been passed. Returning an error from fn
+// stops the call and is returned by StreamCountriesInOrder.
+//
+// Chunks that complete ahead of a slower earlier chunk are buffered until it
+// completes, while later chunks keep being sent. To bound memory, at most twice
+// the concurrency limit of chunks are in flight or buffered at a time, i.e. up
+// to 2 × concurrency × batch size results; a larger limit trades memory for
+// throughput when chunk latencies vary.
+func (v *Validator) StreamCountriesInOrder(ctx context.Context, codes []string, opts CountryOptions, fn func(ValidationResult) error) error {
+	ctx = v.withProgress(ctx, len(codes))
+
+	return v.streamInOrder(ctx, len(codes), func(ctx context.Context, start, end int) ([]ValidationResult, error) {
+		results := make([]ValidationResult, 0, end-start)
+		err := v.retryChunk(ctx, func() error {
+			results = results[:0]
+			return v.StreamCountries(ctx, codes[start:end], opts, func(result ValidationResult) error {
+				results = append(results, result)
+				return nil
+			})
+		})
+		return results, err
+	}, fn)
+}
+
+// StreamSubdivisionsInOrder validates multiple subdivision codes of country like
+// StreamCountriesInOrder, passing the results to fn in input order as soon as
+// all results before them have been passed, with the same memory bound.
+func (v *Validator) StreamSubdivisionsInOrder(ctx context.Context, codes []string, country string, opts SubdivisionOptions, fn func(ValidationResult) error) error {
+	ctx = v.withProgress(ctx, len(codes))
+
+	return v.streamInOrder(ctx, len(codes), func(ctx context.Context, start, end int) ([]ValidationResult, error) {
+		results := make([]ValidationResult, 0, end-start)
+		err := v.retryChunk(ctx, func() error {
+			results = results[:0]
+			return v.StreamSubdivisions(ctx, codes[start:end], country, opts, func(result ValidationResult) error {
+				results = append(results, result)
+				return nil
+			})
+		})
+		return results, err
+	}, fn)
+}
+
+// streamInOrder runs validate for every chunk of the n inputs, concurrently up
+// to v.concurrency, and passes the results to fn in input order through a
+// reorder buffer. Chunks are started in order, and only while fewer than
+// 2 × v.concurrency chunks are in flight or waiting to be passed to fn, so the
+// next chunk to emit has always been started. The first error stops the call.
+func (v *Validator) streamInOrder(ctx context.Context, n int, validate func(ctx context.Context, start, end int) ([]ValidationResult, error), fn func(ValidationResult) error) error {
+	if n == 0 {
+		return nil
+	}
+	chunks := (n + v.batchSize - 1) / v.batchSize
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, slots, _ := concurrencySlots(ctx, v.concurrency)
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	chunkCtx := context.WithValue(ctx, heldSlotKey{}, true)
+
+	ready := make([]chan []ValidationResult, chunks)
+	for j := range ready {
+		ready[j] = make(chan []ValidationResult, 1)
+	}
+	window := make(chan struct{}, 2*v.concurrency)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for j := 0; j < chunks; j++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				start := j * v.batchSize
+				results, err := validate(chunkCtx, start, min(start+v.batchSize, n))
+				if err != nil {
+					cancel(err)
+					return
+				}
+				ready[j] <- results
+			}()
+		}
+	}()
+
+	for j := 0; j < chunks; j++ {
+		select {
+		case results := <-ready[j]:
+			for _, result := range results {
+				if err := fn(result); err != nil {
+					return err
+				}
+			}
+			<-window
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testCountryCodes returns n distinct well-formed country codes: "AA", "AB", ...
+func testCountryCodes(n int) []string {
+	codes := make([]string, n)
+	for i := range codes {
+		codes[i] = string([]byte{'A' + byte(i/26), 'A' + byte(i%26)})
+	}
+	return codes
+}
+
+func TestStreamCountriesInOrderKeepsInputOrder(t *testing.T) {
+	codes := testCountryCodes(12)
+
+	// Earlier chunks answer later, so chunks complete in reverse order.
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, batch []string) bool {
+		for i, code := range codes {
+			if code == batch[0] {
+				time.Sleep(time.Duration(len(codes)-i) * 5 * time.Millisecond)
+			}
+		}
+		return false
+	})
+	v := newTestValidator(t, srv.URL, WithBatchSize(2), WithConcurrency(3))
+
+	var got []string
+	err := v.StreamCountriesInOrder(context.Background(), codes, CountryOptions{}, func(result ValidationResult) error {
+		got = append(got, result.Code)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, codes) {
+		t.Errorf("got %v, want %v", got, codes)
+	}
+}
+
+func TestStreamCountriesInOrderBoundsBuffer(t *testing.T) {
+	codes := testCountryCodes(20)
+	release := make(chan struct{})
+	var started atomic.Int32
+
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, batch []string) bool {
+		started.Add(1)
+		if batch[0] == codes[0] {
+			<-release
+		}
+		return false
+	})
+	v := newTestValidator(t, srv.URL, WithBatchSize(2), WithConcurrency(2))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- v.StreamCountriesInOrder(context.Background(), codes, CountryOptions{}, func(ValidationResult) error { return nil })
+	}()
+
+	// While the first chunk is stuck, only the window of 2 × concurrency
+	// chunks may be sent or buffered.
+	time.Sleep(100 * time.Millisecond)
+	if n := started.Load(); n != 4 {
+		t.Errorf("%d chunks sent while the first was pending, want 4", n)
+	}
+	close(release)
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if n := started.Load(); n != 10 {
+		t.Errorf("%d chunks sent, want 10", n)
+	}
+}
+
+func TestStreamCountriesInOrderCancel(t *testing.T) {
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, batch []string) bool {
+		select {
+		case <-time.After(20 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		return false
+	})
+	v := newTestValidator(t, srv.URL, WithBatchSize(2), WithConcurrency(2))
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := 0
+	err := v.StreamCountriesInOrder(ctx, testCountryCodes(40), CountryOptions{}, func(result ValidationResult) error {
+		if received++; received == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if received >= 40 {
+		t.Errorf("received all %d results after cancelling", received)
+	}
+
+	v.httpClient.CloseIdleConnections()
+	srv.CloseClientConnections()
+	waitForGoroutines(t, before)
+}
+
+func TestStreamCountriesInOrderCallbackError(t *testing.T) {
+	v := echoServer(t, WithBatchSize(2), WithConcurrency(2))
+	stop := errors.New("stop")
+
+	received := 0
+	err := v.StreamCountriesInOrder(context.Background(), testCountryCodes(20), CountryOptions{}, func(result ValidationResult) error {
+		if received++; received == 5 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Fatalf("got %v, want the callback's error", err)
+	}
+	if received != 5 {
+		t.Errorf("fn called %d times, want 5", received)
+	}
+}
+
+// waitForGoroutines fails t unless the number of goroutines drops back to at
+// most want within a second.
+func waitForGoroutines(t *testing.T, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("%d goroutines left, want at most %d:\n%s", runtime.NumGoroutine(), want, buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// ValidateCountryForPayment validates that a country supports a payment method,
+// such as "SEPA", "SWIFT" or "CARD". The method is free-form and sent uppercased.
+// The result's SupportedMethods field lists every payment method the country supports.
+func (v *Validator) ValidateCountryForPayment(ctx context.Context, country, method string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return ValidationResult{Valid: false, Message: "Invalid payment method."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/payment", map[string]any{
+		"method":  method,
+		"country": country,
+	}, &result)
+
+	return result, err
+}
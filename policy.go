@@ -0,0 +1,75 @@
+package validator
+
+import "strings"
+
+const policyDeniedMessage = "Code is denied by policy."
+
+// codePolicy holds the normalized codes set by WithCodePolicy.
+type codePolicy struct {
+	allow map[string]struct{}
+	deny  map[string]struct{}
+}
+
+// WithCodePolicy applies a local allow-list and deny-list before any request is
+// made. Entries are country codes such as "IR" or full subdivision codes such as
+// "UA-43", in any case. The policy takes precedence over the API:
+//
+//   - a denied code is reported as invalid with a policy message, as is any
+//     subdivision of a denied country;
+//   - an allowed code is reported as valid without asking the API (allowing a
+//     country doesn't allow its subdivisions), unless it is outside the
+//     continent required by CountryOptions.RequireContinent.
+//
+// Deny wins when a code is in both lists. Codes covered by neither are
+// validated by the API as usual.
+func WithCodePolicy(allow, deny []string) Option {
+	return func(v *Validator) {
+		v.policy = &codePolicy{allow: policySet(allow), deny: policySet(deny)}
+	}
+}
+
+func policySet(codes []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		if upper, ok := asciiUpper(strings.TrimSpace(code)); ok && upper != "" {
+			set[upper] = struct{}{}
+		}
+	}
+	return set
+}
+
+// countryPolicy returns the result the policy dictates for the normalized country
+// code, if any.
+func (v *Validator) countryPolicy(code string) (ValidationResult, bool) {
+	return v.policyResult(code, code)
+}
+
+// subdivisionPolicy returns the result the policy dictates for the normalized
+// subdivision code of the normalized country, if any.
+func (v *Validator) subdivisionPolicy(code, country string) (ValidationResult, bool) {
+	full := code
+	if !strings.Contains(code, "-") {
+		full = country + "-" + code
+	}
+
+	if v.policy != nil {
+		if _, denied := v.policy.deny[country]; denied {
+			return ValidationResult{Valid: false, Message: policyDeniedMessage, Code: code}, true
+		}
+	}
+	return v.policyResult(full, code)
+}
+
+// policyResult looks up key in the policy and returns a result for code.
+func (v *Validator) policyResult(key, code string) (ValidationResult, bool) {
+	if v.policy == nil {
+		return ValidationResult{}, false
+	}
+	if _, denied := v.policy.deny[key]; denied {
+		return ValidationResult{Valid: false, Message: policyDeniedMessage, Code: code}, true
+	}
+	if _, allowed := v.policy.allow[key]; allowed {
+		return ValidationResult{Valid: true, Code: code}, true
+	}
+	return ValidationResult{}, false
+}
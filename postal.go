@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// PostalValidator validates postal codes for a country.
+// *Validator implements PostalValidator using the CountriesDB API.
+type PostalValidator interface {
+	ValidatePostalCode(ctx context.Context, country string, postal string) (ValidationResult, error)
+}
+
+// PostalValidatorFunc adapts an ordinary function to the PostalValidator interface.
+type PostalValidatorFunc func(ctx context.Context, country string, postal string) (ValidationResult, error)
+
+// ValidatePostalCode calls f(ctx, country, postal).
+func (f PostalValidatorFunc) ValidatePostalCode(ctx context.Context, country string, postal string) (ValidationResult, error) {
+	return f(ctx, country, postal)
+}
+
+// WithPostalValidator validates postal codes of country with pv instead of the CountriesDB API.
+func WithPostalValidator(country string, pv PostalValidator) Option {
+	return func(v *Validator) {
+		if pv == nil {
+			return
+		}
+		if v.postalValidators == nil {
+			v.postalValidators = make(map[string]PostalValidator)
+		}
+		if upper, ok := asciiUpper(country); ok {
+			v.postalValidators[upper] = pv
+		}
+	}
+}
+
+// postalPatterns holds format hints for countries with well-known postal code formats.
+// Postal codes that don't match are rejected without calling the API.
+var postalPatterns = map[string]*regexp.Regexp{
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+	"CA": regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"ES": regexp.MustCompile(`^\d{5}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+	"IN": regexp.MustCompile(`^\d{6}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+}
+
+// ValidatePostalCode validates a postal code for a given country.
+func (v *Validator) ValidatePostalCode(ctx context.Context, country string, postal string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	postal = strings.ToUpper(strings.TrimSpace(postal))
+
+	if pv, ok := v.postalValidators[country]; ok {
+		return pv.ValidatePostalCode(ctx, country, postal)
+	}
+
+	if postal == "" {
+		return ValidationResult{Valid: false, Message: "Invalid postal code."}, nil
+	}
+
+	if pattern, ok := postalPatterns[country]; ok && !pattern.MatchString(postal) {
+		return ValidationResult{Valid: false, Message: "Invalid postal code format."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/postal-code", map[string]any{
+		"postal_code": postal,
+		"country":     country,
+	}, &result)
+
+	return result, err
+}
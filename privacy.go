@@ -0,0 +1,26 @@
+package validator
+
+// EUAdequacyListAsOf is the date the bundled list of EU adequacy decisions was last verified.
+// The list changes as the European Commission adopts or withdraws decisions; update the
+// module to pick up newer data.
+const EUAdequacyListAsOf = "2023-07-10"
+
+// euAdequacy holds countries with an EU adequacy decision. The United States is
+// left out because the EU-US Data Privacy Framework only covers certified organisations.
+var euAdequacy = newCodeSet(
+	"AD", "AR", "CA", "CH", "FO", "GB", "GG", "IL", "IM", "JE", "JP", "KR", "NZ", "UY",
+)
+
+// GDPRApplies reports whether GDPR, or an equivalent regime recognised by an EU
+// adequacy decision, applies to alpha2: EU and EEA members plus the countries
+// with an adequacy decision (as of EUAdequacyListAsOf).
+func GDPRApplies(alpha2 string) bool {
+	return IsEEAMember(alpha2) || inCodeSet(euAdequacy, alpha2)
+}
+
+// CCPAApplies reports whether the California Consumer Privacy Act can apply to alpha2.
+// CCPA is a California law, so this is true only for "US"; callers that know the
+// subdivision should additionally check for "US-CA".
+func CCPAApplies(alpha2 string) bool {
+	return normalizeAlpha2(alpha2) == "US"
+}
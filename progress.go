@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"context"
+	"sync"
+)
+
+// WithProgress calls fn as batch methods such as ValidateCountries,
+// ValidateCountriesBatch or ValidateCountriesFromCSV work through their input,
+// each time a chunk of codes (see WithBatchSize) completes: done is the number of
+// codes validated so far and total the number in the call. Calls are serialized,
+// even when chunks run concurrently, and done only grows. Methods reading from an
+// io.Reader, whose total isn't known, don't report progress.
+func WithProgress(fn func(done, total int)) Option {
+	return func(v *Validator) {
+		v.progress = fn
+	}
+}
+
+type progressKey struct{}
+
+// progressTracker counts the codes completed by one batch call.
+type progressTracker struct {
+	mu    sync.Mutex
+	done  int
+	total int
+	fn    func(done, total int)
+}
+
+// withProgress returns a context tracking the progress of a batch call of total
+// codes. Without WithProgress, or within an enclosing batch call that already
+// tracks its progress, ctx is returned unchanged.
+func (v *Validator) withProgress(ctx context.Context, total int) context.Context {
+	if v.progress == nil || ctx.Value(progressKey{}) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, progressKey{}, &progressTracker{total: total, fn: v.progress})
+}
+
+// reportProgress records that n more codes of the batch call tracked by ctx are done.
+func reportProgress(ctx context.Context, n int) {
+	tracker, ok := ctx.Value(progressKey{}).(*progressTracker)
+	if !ok || n == 0 {
+		return
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.done = min(tracker.done+n, tracker.total)
+	tracker.fn(tracker.done, tracker.total)
+}
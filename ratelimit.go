@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitStatus is the rate-limit budget reported by the API.
+type RateLimitStatus struct {
+	// Limit is the number of requests allowed per window (X-RateLimit-Limit).
+	Limit int
+	// Remaining is the number of requests left in the current window
+	// (X-RateLimit-Remaining).
+	Remaining int
+	// Reset is when the current window ends (X-RateLimit-Reset), or the zero
+	// time if the API didn't report it.
+	Reset time.Time
+}
+
+// rateLimitState holds the latest RateLimitStatus of a Validator.
+type rateLimitState struct {
+	mu     sync.Mutex
+	status RateLimitStatus
+}
+
+// RateLimitStatus returns the rate-limit budget reported by the most recent
+// response carrying X-RateLimit-* headers, e.g. to slow down batch jobs as
+// Remaining approaches zero. It is the zero value until such a response has been
+// received. Clones keep their own status. It is safe for concurrent use.
+func (v *Validator) RateLimitStatus() RateLimitStatus {
+	v.rateLimit.mu.Lock()
+	defer v.rateLimit.mu.Unlock()
+	return v.rateLimit.status
+}
+
+// recordRateLimit updates the rate-limit status from the headers of a response,
+// if it reports the remaining budget.
+func (v *Validator) recordRateLimit(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+
+	status := RateLimitStatus{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		status.Reset = rateLimitReset(reset, time.Now())
+	}
+
+	v.rateLimit.mu.Lock()
+	v.rateLimit.status = status
+	v.rateLimit.mu.Unlock()
+}
+
+// rateLimitReset interprets an X-RateLimit-Reset value, which APIs send either
+// as a Unix timestamp or as seconds from now. Values too small to be a recent
+// timestamp are taken as seconds from now.
+func rateLimitReset(value int64, now time.Time) time.Time {
+	if value > 1_000_000_000 {
+		return time.Unix(value, 0)
+	}
+	return now.Add(time.Duration(value) * time.Second)
+}
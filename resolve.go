@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// ResolveCountry resolves a free-form country name typed by a user, such as
+// "United States" or "Deutschland", to its ISO 3166-1 alpha-2 code, returned in
+// the result's Code. When the name matches more than one country, the result is
+// invalid and its Candidates field lists the codes of the possible matches, e.g.
+// for the user to choose from. Resolved codes denied by WithCodePolicy are
+// reported as invalid, and opts.RequireContinent is applied as in
+// ValidateCountry; opts.FollowUpward has no effect.
+func (v *Validator) ResolveCountry(ctx context.Context, name string, opts CountryOptions) (ValidationResult, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ValidationResult{Valid: false, Message: "Invalid country name."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/resolve/country", map[string]any{
+		"name": name,
+	}, &result)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Valid {
+		if denied, ok := v.countryPolicy(result.Code); ok && !denied.Valid {
+			return denied, nil
+		}
+	}
+
+	return requireContinent(result, opts), nil
+}
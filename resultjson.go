@@ -0,0 +1,50 @@
+package validator
+
+import "encoding/json"
+
+// plainResult is ValidationResult without its UnmarshalJSON method.
+type plainResult ValidationResult
+
+// resultJSON is the wire form of ValidationResult. Besides the fields of
+// ValidationResult it accepts the aliases used by some backend variants:
+// "is_valid" for "valid" and "reason" for "message". Having no UnmarshalJSON
+// method of its own, it honors json.Decoder.DisallowUnknownFields (see
+// WithStrictDecoding).
+type resultJSON struct {
+	plainResult
+	IsValid *bool   `json:"is_valid"`
+	Reason  *string `json:"reason"`
+}
+
+func (w resultJSON) result() ValidationResult {
+	result := ValidationResult(w.plainResult)
+	if w.IsValid != nil {
+		result.Valid = *w.IsValid
+	}
+	if w.Reason != nil && result.Message == "" {
+		result.Message = *w.Reason
+	}
+	return result
+}
+
+// UnmarshalJSON decodes a result, accepting "is_valid" as an alias for "valid"
+// and "reason" as an alias for "message", as sent by some backend variants.
+func (r *ValidationResult) UnmarshalJSON(data []byte) error {
+	var w resultJSON
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	*r = w.result()
+	return nil
+}
+
+// decodeResult decodes the next result from dec. Unlike decoding into a
+// ValidationResult, which goes through UnmarshalJSON, it keeps the decoder's
+// DisallowUnknownFields setting in effect.
+func decodeResult(dec *json.Decoder) (ValidationResult, error) {
+	var w resultJSON
+	if err := dec.Decode(&w); err != nil {
+		return ValidationResult{}, err
+	}
+	return w.result(), nil
+}
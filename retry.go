@@ -0,0 +1,243 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// BackoffStrategy decides how long to wait before a retry.
+type BackoffStrategy interface {
+	// NextDelay returns the delay before retry number attempt (starting at 1).
+	NextDelay(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same Delay before every retry.
+type ConstantBackoff struct {
+	Delay time.Duration
+}
+
+// NextDelay returns b.Delay.
+func (b ConstantBackoff) NextDelay(attempt int) time.Duration {
+	return b.Delay
+}
+
+// ExponentialBackoff waits Initial before the first retry and multiplies the
+// delay by Multiplier (2 if zero) for every further retry, capped at Max (if non-zero).
+type ExponentialBackoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+// NextDelay returns the exponentially growing delay for attempt.
+func (b ExponentialBackoff) NextDelay(attempt int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+
+	delay := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if b.Max > 0 && delay > float64(b.Max) {
+		return b.Max
+	}
+	return time.Duration(delay)
+}
+
+var defaultBackoff BackoffStrategy = ExponentialBackoff{
+	Initial: 200 * time.Millisecond,
+	Max:     5 * time.Second,
+}
+
+// WithRetry retries requests that fail with a connection error, a retryable
+// status (see WithRetryableStatusCodes) or 429 Too Many Requests up to
+// maxRetries times. A 429 waits as long as its Retry-After header asks, up to
+// 30 seconds, instead of the backoff delay. Batch methods
+// likewise rerun a chunk whose response is cut off by a network failure.
+// Retries are disabled by default.
+//
+// Validation requests are POSTs but have no side effects, so they are treated as
+// idempotent: a request retried after the server processed it returns the same
+// result. Retries are billed like any other request, so use
+// WithRetryableStatusCodes to narrow which responses are retried.
+func WithRetry(maxRetries int) Option {
+	return func(v *Validator) {
+		if maxRetries >= 0 {
+			v.maxRetries = maxRetries
+		}
+	}
+}
+
+// defaultRetryableStatusCodes are the statuses retried unless
+// WithRetryableStatusCodes is used. 429 is handled separately by shouldRetry.
+var defaultRetryableStatusCodes = map[int]bool{
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// WithRetryableStatusCodes sets exactly which HTTP statuses are retried by
+// WithRetry, replacing the default of 502, 503 and 504. Connection errors and
+// 429 responses, which follow Retry-After, are always retried; calling it with
+// no codes retries only those.
+func WithRetryableStatusCodes(codes ...int) Option {
+	return func(v *Validator) {
+		v.retryableStatusCodes = make(map[int]bool, len(codes))
+		for _, code := range codes {
+			v.retryableStatusCodes[code] = true
+		}
+	}
+}
+
+// WithBackoff sets the delay between retries (defaults to exponential backoff
+// starting at 200ms and capped at 5s). It has no effect without WithRetry.
+func WithBackoff(b BackoffStrategy) Option {
+	return func(v *Validator) {
+		if b != nil {
+			v.backoff = b
+		}
+	}
+}
+
+// maxRetryAfter caps the Retry-After delay honored before retrying a 429.
+const maxRetryAfter = 30 * time.Second
+
+// do sends body to path, retrying transient failures as configured by WithRetry.
+// Every attempt carries the same header, so an Idempotency-Key is reused across retries.
+// A 429 with a Retry-After header waits that long, up to maxRetryAfter, instead
+// of the backoff delay.
+func (v *Validator) do(ctx context.Context, path string, body []byte, header http.Header) (*http.Response, error) {
+	var delay time.Duration
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := v.send(ctx, path, body, header)
+		if attempt >= v.retriesFor(ctx) || !v.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay = v.backoff.NextDelay(attempt + 1)
+		if resp != nil {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				if d, ok := retryAfter(resp.Header); ok {
+					delay = min(d, maxRetryAfter)
+				}
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, errorBodyLimit))
+			resp.Body.Close()
+		}
+	}
+}
+
+// retryChunk runs fn, one chunk of a batch call, and reruns it with the
+// configured backoff when it fails transiently after its request was sent, e.g.
+// because the connection dropped while the response was being read, up to the
+// number of retries set by WithRetry. Failures to send a request are already
+// retried by do. fn must store results by position so that a rerun overwrites
+// those of the failed attempt and the results stay in input order.
+func (v *Validator) retryChunk(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, v.backoff.NextDelay(attempt)); err != nil {
+				return err
+			}
+		}
+
+		err := fn()
+		if err == nil || attempt >= v.retriesFor(ctx) || !isTransientReadError(ctx, err) {
+			return err
+		}
+	}
+}
+
+// isTransientReadError reports whether err is a network failure while reading a
+// response, as opposed to one while sending the request, which the http.Client
+// reports as a *url.Error.
+func isTransientReadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr)
+}
+
+// shouldRetry reports whether a request that produced resp or err is worth retrying.
+func (v *Validator) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// A timeout of the http.Client also matches context.DeadlineExceeded, so
+		// only ctx tells whether the call itself is over.
+		return ctx.Err() == nil && isConnectionError(err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	retryable := v.retryableStatusCodes
+	if retryable == nil {
+		retryable = defaultRetryableStatusCodes
+	}
+	return retryable[resp.StatusCode]
+}
+
+// isConnectionError reports whether err, returned by the http.Client, is a
+// network failure such as a timeout or reset connection, as opposed to a
+// permanent one such as a malformed URL or an untrusted TLS certificate.
+func isConnectionError(err error) bool {
+	// *url.Error implements net.Error itself, so look at what it wraps.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.As(err, &netErr)
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryAfterClientTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			select {
+			case <-time.After(300 * time.Millisecond):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Write([]byte(`{"valid":true,"code":"US"}`))
+	}))
+	defer srv.Close()
+
+	v, err := NewValidator("test-api-key", WithBaseURL(srv.URL), WithTimeout(50*time.Millisecond),
+		WithRetry(3), WithBackoff(ConstantBackoff{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := v.ValidateCountry(context.Background(), "US", CountryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid {
+		t.Errorf("got %+v, want valid", result)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("made %d attempts, want 3", n)
+	}
+}
+
+func TestNoRetryAfterCallerDeadline(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		select {
+		case <-time.After(300 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+
+	v, err := NewValidator("test-api-key", WithBaseURL(srv.URL), WithRetry(3), WithBackoff(ConstantBackoff{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := v.ValidateCountry(ctx, "US", CountryOptions{}); err == nil {
+		t.Fatal("got no error, want the deadline to end the call")
+	}
+	if n := attempts.Load(); n != 1 {
+		t.Errorf("made %d attempts, want 1", n)
+	}
+}
+
+func TestRetryChunkAfterTruncatedBody(t *testing.T) {
+	codes := testCountryCodes(7)
+	var truncated atomic.Bool
+
+	// The first response for the second chunk is cut off halfway through.
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, batch []string) bool {
+		if batch[0] != codes[2] || !truncated.CompareAndSwap(false, true) {
+			return false
+		}
+		body, _ := json.Marshal(map[string]any{"results": echoResults(batch)})
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.Write(body[:len(body)/2])
+		return true
+	})
+	v := newTestValidator(t, srv.URL, WithBatchSize(2), WithConcurrency(3), WithRetry(1), WithBackoff(ConstantBackoff{}))
+
+	results, err := v.ValidateCountries(context.Background(), codes, CountryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !truncated.Load() {
+		t.Fatal("no response was truncated")
+	}
+
+	got := make([]string, len(results))
+	for i, result := range results {
+		got[i] = result.Code
+	}
+	if !reflect.DeepEqual(got, codes) {
+		t.Errorf("got %v, want %v", got, codes)
+	}
+}
+
+func TestTruncatedBodyWithoutRetryFails(t *testing.T) {
+	srv := startEchoServer(t, func(w http.ResponseWriter, r *http.Request, batch []string) bool {
+		w.Header().Set("Content-Length", "1000")
+		w.Write([]byte(`{"results":[`))
+		return true
+	})
+	v := newTestValidator(t, srv.URL)
+
+	if _, err := v.ValidateCountries(context.Background(), []string{"US", "CA"}, CountryOptions{}); err == nil {
+		t.Fatal("got no error for a truncated body")
+	}
+}
@@ -0,0 +1,76 @@
+package validator
+
+// RiskLevel is a coarse country risk tier derived from the bundled FATF and sanctions data.
+type RiskLevel int
+
+const (
+	RiskLow        RiskLevel = iota // no FATF listing or sanctions
+	RiskMedium                      // FATF increased monitoring (greylist)
+	RiskHigh                        // EU or UN sanctions
+	RiskProhibited                  // FATF call for action (blacklist) or OFAC sanctions
+)
+
+// String returns the lowercase name of the risk level.
+func (l RiskLevel) String() string {
+	switch l {
+	case RiskLow:
+		return "low"
+	case RiskMedium:
+		return "medium"
+	case RiskHigh:
+		return "high"
+	case RiskProhibited:
+		return "prohibited"
+	default:
+		return "unknown"
+	}
+}
+
+// RiskDetails explains a RiskLevel.
+type RiskDetails struct {
+	Level   RiskLevel
+	Reasons []string
+}
+
+// CountryRiskLevel returns the risk tier of alpha2. See CountryRiskLevelDetails.
+func CountryRiskLevel(alpha2 string) (RiskLevel, error) {
+	details, err := CountryRiskLevelDetails(alpha2)
+	return details.Level, err
+}
+
+// CountryRiskLevelDetails returns the risk tier of alpha2 together with every
+// listing that contributed to it. The tier is the highest one implied by any
+// listing in the bundled FATF (FATFListAsOf) and sanctions (SanctionsListAsOf) data.
+// It returns ErrUnknownCountry for codes that are not assigned ISO 3166-1 codes.
+func CountryRiskLevelDetails(alpha2 string) (RiskDetails, error) {
+	code, err := lookupCountry(alpha2)
+	if err != nil {
+		return RiskDetails{}, err
+	}
+
+	details := RiskDetails{Level: RiskLow}
+	add := func(level RiskLevel, reason string) {
+		if level > details.Level {
+			details.Level = level
+		}
+		details.Reasons = append(details.Reasons, reason)
+	}
+
+	if IsOnFATFBlacklist(code) {
+		add(RiskProhibited, "FATF blacklisted")
+	}
+	if IsSanctioned(code, OFAC) {
+		add(RiskProhibited, "OFAC sanctioned")
+	}
+	if IsSanctioned(code, UN) {
+		add(RiskHigh, "UN sanctioned")
+	}
+	if IsSanctioned(code, EU) {
+		add(RiskHigh, "EU sanctioned")
+	}
+	if IsOnFATFGreylist(code) {
+		add(RiskMedium, "FATF greylisted")
+	}
+
+	return details, nil
+}
@@ -0,0 +1,33 @@
+package validator
+
+// SanctionsListAsOf is the date the bundled sanctions data was last verified.
+// Only country-wide or broad sectoral programs are included; update the module
+// to pick up newer data.
+const SanctionsListAsOf = "2025-06-30"
+
+// SanctionAuthority identifies a sanctioning authority. Values can be combined
+// with | to check several authorities at once.
+type SanctionAuthority uint8
+
+const (
+	OFAC SanctionAuthority = 1 << iota // US Office of Foreign Assets Control
+	EU                                 // European Union restrictive measures
+	UN                                 // UN Security Council sanctions regimes
+)
+
+var sanctions = map[SanctionAuthority]map[string]struct{}{
+	OFAC: newCodeSet("BY", "CU", "IR", "KP", "RU", "SY", "VE"),
+	EU:   newCodeSet("BY", "CF", "CD", "IQ", "IR", "KP", "LY", "MM", "RU", "SD", "SS", "SY", "VE", "YE", "ZW"),
+	UN:   newCodeSet("CD", "CF", "IQ", "KP", "LY", "SD", "SO", "SS", "YE"),
+}
+
+// IsSanctioned reports whether alpha2 is sanctioned by any of the authorities in
+// the authority mask, e.g. IsSanctioned("IR", OFAC|EU) (as of SanctionsListAsOf).
+func IsSanctioned(alpha2 string, authority SanctionAuthority) bool {
+	for a, set := range sanctions {
+		if authority&a != 0 && inCodeSet(set, alpha2) {
+			return true
+		}
+	}
+	return false
+}
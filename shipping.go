@@ -0,0 +1,25 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// ValidateCountryForShipping validates that a carrier, such as "DHL" or "USPS",
+// ships to a country. With an empty carrier, the result reports whether
+// international shipping to the country is generally accepted. The result's
+// Restrictions field lists known restrictions, e.g. "no PO Boxes".
+func (v *Validator) ValidateCountryForShipping(ctx context.Context, country, carrier string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/shipping", map[string]any{
+		"carrier": strings.ToUpper(strings.TrimSpace(carrier)),
+		"country": country,
+	}, &result)
+
+	return result, err
+}
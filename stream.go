@@ -0,0 +1,309 @@
+package validator
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const defaultBatchSize = 100
+
+// WithBatchSize sets how many codes are sent per request by batch methods such
+// as ValidateCountries or ValidateCountriesStream, which split larger inputs into
+// chunks (defaults to 100).
+func WithBatchSize(n int) Option {
+	return func(v *Validator) {
+		if n > 0 {
+			v.batchSize = n
+		}
+	}
+}
+
+// resultSink receives batch results one at a time as they are decoded.
+// Passing a resultSink to post streams the "results" array instead of
+// decoding it into a slice.
+type resultSink func(ValidationResult) error
+
+// StreamCountries validates multiple country codes like ValidateCountries, but
+// passes each result to fn as it is decoded instead of building a slice.
+// Returning an error from fn stops decoding and is returned by StreamCountries.
+func (v *Validator) StreamCountries(ctx context.Context, codes []string, opts CountryOptions, fn func(ValidationResult) error) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	payload, local := v.countriesPayload(codes)
+	return v.postBatch(ctx, "/api/validate/country", payload, len(codes), local, func(result ValidationResult) error {
+		return fn(requireContinent(result, opts))
+	})
+}
+
+// StreamSubdivisions validates multiple subdivision codes like ValidateSubdivisions, but
+// passes each result to fn as it is decoded instead of building a slice.
+// Returning an error from fn stops decoding and is returned by StreamSubdivisions.
+func (v *Validator) StreamSubdivisions(ctx context.Context, codes []string, country string, opts SubdivisionOptions, fn func(ValidationResult) error) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	payload, local, err := v.subdivisionsPayload(codes, country, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.FollowRelated {
+		return v.streamSubdivisionsSingly(ctx, codes, country, opts, fn)
+	}
+
+	return v.postBatch(ctx, "/api/validate/subdivision", payload, len(codes), local, fn)
+}
+
+// streamSubdivisionsSingly validates each code with its own request, because the
+// API only follows related subdivisions for single codes. Requests run
+// concurrently up to the limit set by WithConcurrency and the results are passed
+// to fn in input order once all have completed.
+func (v *Validator) streamSubdivisionsSingly(ctx context.Context, codes []string, country string, opts SubdivisionOptions, fn func(ValidationResult) error) error {
+	results := make([]ValidationResult, len(codes))
+
+	err := runConcurrent(ctx, v.concurrency, len(codes), func(ctx context.Context, i int) error {
+		result, err := v.validateSubdivision(ctx, codes[i], country, opts)
+		results[i] = result
+		if err == nil {
+			reportProgress(ctx, 1)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if err := fn(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateCountriesStream reads one country code per line from r, validates them
+// in chunks of the configured batch size (see WithBatchSize), and sends each result
+// to results in input order as soon as its chunk is decoded. Blank lines are skipped.
+// results is closed when ValidateCountriesStream returns.
+func (v *Validator) ValidateCountriesStream(ctx context.Context, r io.Reader, opts CountryOptions, results chan<- ValidationResult) error {
+	defer close(results)
+
+	return v.streamLines(r, func(codes []string) error {
+		return v.StreamCountries(ctx, codes, opts, sendTo(ctx, results))
+	})
+}
+
+// ValidateSubdivisionsStream reads one subdivision code of country per line from r,
+// validates them in chunks of the configured batch size (see WithBatchSize), and
+// sends each result to results in input order as soon as its chunk is decoded.
+// Blank lines are skipped. results is closed when ValidateSubdivisionsStream returns.
+func (v *Validator) ValidateSubdivisionsStream(ctx context.Context, r io.Reader, country string, opts SubdivisionOptions, results chan<- ValidationResult) error {
+	defer close(results)
+
+	return v.streamLines(r, func(codes []string) error {
+		return v.StreamSubdivisions(ctx, codes, country, opts, sendTo(ctx, results))
+	})
+}
+
+// ValidateCountriesToWriter validates multiple country codes and writes each
+// result to w as newline-delimited JSON (one ValidationResult object per line)
+// as soon as it is decoded. Codes are sent in chunks of the configured batch
+// size (see WithBatchSize).
+func (v *Validator) ValidateCountriesToWriter(ctx context.Context, codes []string, opts CountryOptions, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	ctx = v.withProgress(ctx, len(codes))
+
+	for start := 0; start < len(codes); start += v.batchSize {
+		end := min(start+v.batchSize, len(codes))
+
+		err := v.StreamCountries(ctx, codes[start:end], opts, func(result ValidationResult) error {
+			return enc.Encode(result)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamLines reads non-blank lines from r and passes them to flush in chunks of
+// at most v.batchSize lines.
+func (v *Validator) streamLines(r io.Reader, flush func(codes []string) error) error {
+	scanner := bufio.NewScanner(r)
+	chunk := make([]string, 0, v.batchSize)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		chunk = append(chunk, line)
+		if len(chunk) == v.batchSize {
+			if err := flush(chunk); err != nil {
+				return err
+			}
+			chunk = chunk[:0]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return flush(chunk)
+	}
+
+	return nil
+}
+
+// sendTo returns a result callback that sends to results until ctx is done.
+func sendTo(ctx context.Context, results chan<- ValidationResult) func(ValidationResult) error {
+	return func(result ValidationResult) error {
+		select {
+		case results <- result:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// postBatch sends a multi-select request for the codes that weren't resolved
+// locally and emits every result to fn in input order, interleaving the local
+// results (keyed by input index) with those decoded from the response. Results
+// are aligned with the codes sent by position: a result without a Code gets the
+// code that was sent, and a response with more or fewer results than codes sent
+// is an error.
+func (v *Validator) postBatch(ctx context.Context, path string, payload map[string]any, n int, local map[int]ValidationResult, fn func(ValidationResult) error) error {
+	next := 0
+	drainLocal := func() error {
+		for next < n {
+			result, ok := local[next]
+			if !ok {
+				return nil
+			}
+			next++
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if sent, _ := payload["code"].([]string); len(sent) > 0 {
+		received := 0
+		err := v.post(ctx, path, payload, resultSink(func(result ValidationResult) error {
+			if received == len(sent) {
+				return fmt.Errorf("countriesdb: got more than %d results for %d codes", len(sent), len(sent))
+			}
+			if result.Code == "" {
+				result.Code = sent[received]
+			}
+			received++
+
+			if err := drainLocal(); err != nil {
+				return err
+			}
+			next++
+			return fn(result)
+		}))
+		if err != nil {
+			return err
+		}
+		if received != len(sent) {
+			return fmt.Errorf("countriesdb: got %d results for %d codes", received, len(sent))
+		}
+	}
+
+	if err := drainLocal(); err != nil {
+		return err
+	}
+
+	reportProgress(ctx, n)
+	return nil
+}
+
+// sinkError marks an error returned by a resultSink so that post can return it
+// unchanged instead of reporting it as a decoding failure.
+type sinkError struct {
+	err error
+}
+
+func (e sinkError) Error() string {
+	return e.err.Error()
+}
+
+// streamResults walks a {"results": [...]} document token by token and hands
+// each element to sink, so memory stays flat regardless of the number of results.
+func streamResults(r io.Reader, sink resultSink, strict bool) error {
+	dec := json.NewDecoder(r)
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if key, _ := tok.(string); key != "results" {
+			if strict {
+				return fmt.Errorf("json: unknown field %q", key)
+			}
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return err
+		}
+
+		for dec.More() {
+			result, err := decodeResult(dec)
+			if err != nil {
+				return err
+			}
+			if err := sink(result); err != nil {
+				return sinkError{err}
+			}
+		}
+
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if got, ok := tok.(json.Delim); !ok || got != want {
+		return fmt.Errorf("countriesdb: unexpected token %v, want %v", tok, want)
+	}
+
+	return nil
+}
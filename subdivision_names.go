@@ -0,0 +1,32 @@
+package validator
+
+// subdivisionNames maps lowercase subdivision names to their ISO 3166-2
+// subdivision codes (without the country prefix), per country.
+var subdivisionNames = map[string]map[string]string{
+	"US": {
+		"alabama": "AL", "alaska": "AK", "arizona": "AZ", "arkansas": "AR", "california": "CA",
+		"colorado": "CO", "connecticut": "CT", "delaware": "DE", "florida": "FL", "georgia": "GA",
+		"hawaii": "HI", "idaho": "ID", "illinois": "IL", "indiana": "IN", "iowa": "IA",
+		"kansas": "KS", "kentucky": "KY", "louisiana": "LA", "maine": "ME", "maryland": "MD",
+		"massachusetts": "MA", "michigan": "MI", "minnesota": "MN", "mississippi": "MS", "missouri": "MO",
+		"montana": "MT", "nebraska": "NE", "nevada": "NV", "new hampshire": "NH", "new jersey": "NJ",
+		"new mexico": "NM", "new york": "NY", "north carolina": "NC", "north dakota": "ND", "ohio": "OH",
+		"oklahoma": "OK", "oregon": "OR", "pennsylvania": "PA", "rhode island": "RI", "south carolina": "SC",
+		"south dakota": "SD", "tennessee": "TN", "texas": "TX", "utah": "UT", "vermont": "VT",
+		"virginia": "VA", "washington": "WA", "west virginia": "WV", "wisconsin": "WI", "wyoming": "WY",
+		"district of columbia": "DC", "washington dc": "DC", "american samoa": "AS", "guam": "GU",
+		"northern mariana islands": "MP", "puerto rico": "PR", "united states virgin islands": "VI",
+		"us virgin islands": "VI", "united states minor outlying islands": "UM",
+	},
+	"CA": {
+		"alberta": "AB", "british columbia": "BC", "manitoba": "MB", "new brunswick": "NB",
+		"newfoundland and labrador": "NL", "northwest territories": "NT", "nova scotia": "NS",
+		"nunavut": "NU", "ontario": "ON", "prince edward island": "PE", "quebec": "QC", "québec": "QC",
+		"saskatchewan": "SK", "yukon": "YT",
+	},
+	"AU": {
+		"australian capital territory": "ACT", "new south wales": "NSW", "northern territory": "NT",
+		"queensland": "QLD", "south australia": "SA", "tasmania": "TAS", "victoria": "VIC",
+		"western australia": "WA",
+	},
+}
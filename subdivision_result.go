@@ -0,0 +1,57 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SubdivisionResult is the result of validating a subdivision together with the
+// country it was validated against, so that results stay self-contained when
+// passed around.
+type SubdivisionResult struct {
+	ValidationResult
+	// Country is the country the subdivision was validated against, trimmed and
+	// uppercased.
+	Country string `json:"country"`
+}
+
+// UnmarshalJSON decodes a result like ValidationResult.UnmarshalJSON, together
+// with its country.
+func (r *SubdivisionResult) UnmarshalJSON(data []byte) error {
+	if err := r.ValidationResult.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	var country struct {
+		Country string `json:"country"`
+	}
+	if err := json.Unmarshal(data, &country); err != nil {
+		return err
+	}
+	r.Country = country.Country
+	return nil
+}
+
+// ValidateSubdivisionRef validates a single subdivision like ValidateSubdivision
+// and returns the result together with its country.
+func (v *Validator) ValidateSubdivisionRef(ctx context.Context, ref SubdivisionRef, opts SubdivisionOptions) (SubdivisionResult, error) {
+	result, err := v.ValidateSubdivision(ctx, ref.Code, ref.Country, opts)
+	return SubdivisionResult{ValidationResult: result, Country: normalizeAlpha2(ref.Country)}, err
+}
+
+// ValidateSubdivisionRefs validates subdivisions of possibly different countries
+// like ValidateSubdivisionPairs and returns the results, in input order,
+// together with their countries.
+func (v *Validator) ValidateSubdivisionRefs(ctx context.Context, refs []SubdivisionRef, opts SubdivisionOptions) ([]SubdivisionResult, error) {
+	results, err := v.ValidateSubdivisionPairs(ctx, refs, opts)
+	if results == nil {
+		return nil, err
+	}
+
+	withCountry := make([]SubdivisionResult, len(results))
+	for i, result := range results {
+		withCountry[i] = SubdivisionResult{ValidationResult: result, Country: normalizeAlpha2(refs[i].Country)}
+	}
+
+	return withCountry, err
+}
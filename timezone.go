@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// ValidateTimezone validates that an IANA timezone (e.g. "America/New_York") belongs to a country.
+// The result's Timezones field lists every timezone associated with the country.
+func (v *Validator) ValidateTimezone(ctx context.Context, timezone string, country string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	if !isValidTimezoneFormat(timezone) {
+		return ValidationResult{Valid: false, Message: "Invalid timezone."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/timezone", map[string]any{
+		"timezone": timezone,
+		"country":  country,
+	}, &result)
+
+	return result, err
+}
+
+// isValidTimezoneFormat reports whether tz looks like an IANA "Area/Location" identifier.
+func isValidTimezoneFormat(tz string) bool {
+	parts := strings.Split(tz, "/")
+	if len(parts) < 2 {
+		return false
+	}
+
+	for _, part := range parts {
+		if part == "" || len(part) > 30 {
+			return false
+		}
+		for _, r := range part {
+			switch {
+			case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			case r == '_', r == '-', r == '+':
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"context"
+	"strings"
+)
+
+// ValidateTLD validates that a country-code top-level domain (e.g. ".de" or "de") belongs to a country.
+// The result's TLDs field lists every top-level domain associated with the country.
+func (v *Validator) ValidateTLD(ctx context.Context, tld string, country string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	tld = normalizeTLD(tld)
+	if !isValidTLDFormat(tld) {
+		return ValidationResult{Valid: false, Message: "Invalid top-level domain."}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/tld", map[string]any{
+		"tld":     tld,
+		"country": country,
+	}, &result)
+
+	return result, err
+}
+
+// normalizeTLD lowercases tld and adds a single leading dot.
+func normalizeTLD(tld string) string {
+	return "." + strings.TrimPrefix(strings.ToLower(strings.TrimSpace(tld)), ".")
+}
+
+// isValidTLDFormat reports whether tld is a dot-separated sequence of DNS labels.
+func isValidTLDFormat(tld string) bool {
+	for _, label := range strings.Split(strings.TrimPrefix(tld, "."), ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
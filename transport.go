@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// TransportConfig tunes the connection pool of the Validator's HTTP transport.
+// Zero fields use the defaults.
+type TransportConfig struct {
+	// MaxIdleConns limits idle connections across all hosts (default 100).
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits idle connections kept to the API host (default 10).
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout closes idle connections after this long (default 90s).
+	IdleConnTimeout time.Duration
+	// MaxConnsPerHost limits connections to the API host, including active ones
+	// (default unlimited); further requests wait for a free connection.
+	MaxConnsPerHost int
+	// ForceHTTP1 disables HTTP/2, which is otherwise negotiated via ALPN
+	// whenever the server supports it.
+	ForceHTTP1 bool
+}
+
+// WithTransportConfig tunes connection pooling and keep-alive, e.g. for
+// high-throughput jobs. It replaces the Transport of the http.Client (on a
+// copy when the client was supplied with WithHTTPClient).
+func WithTransportConfig(cfg TransportConfig) Option {
+	return func(v *Validator) {
+		v.transportConfig = &cfg
+	}
+}
+
+// WithConnectionPool sizes the connection pool, e.g. for high-throughput jobs
+// that should reuse connections rather than open new ones. It is shorthand for
+// WithTransportConfig with the given limits, keeping any IdleConnTimeout set
+// by an earlier WithTransportConfig.
+func WithConnectionPool(maxIdle, maxIdlePerHost, maxConnsPerHost int) Option {
+	return func(v *Validator) {
+		var cfg TransportConfig
+		if v.transportConfig != nil {
+			cfg = *v.transportConfig
+		}
+		cfg.MaxIdleConns = maxIdle
+		cfg.MaxIdleConnsPerHost = maxIdlePerHost
+		cfg.MaxConnsPerHost = maxConnsPerHost
+		v.transportConfig = &cfg
+	}
+}
+
+// WithForceHTTP1 makes the Validator use HTTP/1.1 even when the server supports
+// HTTP/2, for environments such as proxies where HTTP/2 misbehaves. It sets
+// TransportConfig.ForceHTTP1, keeping any other settings from an earlier
+// WithTransportConfig or WithConnectionPool.
+func WithForceHTTP1() Option {
+	return func(v *Validator) {
+		var cfg TransportConfig
+		if v.transportConfig != nil {
+			cfg = *v.transportConfig
+		}
+		cfg.ForceHTTP1 = true
+		v.transportConfig = &cfg
+	}
+}
+
+// newTransport returns a copy of http.DefaultTransport tuned with cfg. Like
+// http.DefaultTransport, it negotiates HTTP/2 unless cfg.ForceHTTP1 is set.
+func newTransport(cfg TransportConfig) *http.Transport {
+	if cfg.MaxIdleConns == 0 {
+		cfg.MaxIdleConns = 100
+	}
+	if cfg.MaxIdleConnsPerHost == 0 {
+		cfg.MaxIdleConnsPerHost = 10
+	}
+	if cfg.IdleConnTimeout == 0 {
+		cfg.IdleConnTimeout = 90 * time.Second
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	if cfg.ForceHTTP1 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+	return transport
+}
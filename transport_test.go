@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransportNegotiatesProtocol(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+
+	for _, tc := range []struct {
+		name string
+		cfg  TransportConfig
+		want string
+	}{
+		{"default", TransportConfig{}, "HTTP/2.0"},
+		{"ForceHTTP1", TransportConfig{ForceHTTP1: true}, "HTTP/1.1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newTransport(tc.cfg)
+			transport.TLSClientConfig = &tls.Config{RootCAs: roots}
+			defer transport.CloseIdleConnections()
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.Proto != tc.want {
+				t.Errorf("negotiated %s, want %s", resp.Proto, tc.want)
+			}
+		})
+	}
+}
@@ -4,26 +4,91 @@ package validator
 type ValidationResult struct {
 	Valid   bool   `json:"valid"`
 	Message string `json:"message,omitempty"`
-	Code    string `json:"code,omitempty"`
+	// Code is the canonical form of the validated code as reported by the API.
+	// It may differ from the input, e.g. when a deprecated alias maps to a current code.
+	Code string `json:"code,omitempty"`
+
+	// Name is the display name of the code, localized with WithLanguage.
+	Name string `json:"name,omitempty"`
+
+	// Timezones lists the IANA timezones of the country (ValidateTimezone only).
+	Timezones []string `json:"timezones,omitempty"`
+
+	// TLDs lists the country-code top-level domains of the country (ValidateTLD only).
+	TLDs []string `json:"tlds,omitempty"`
+
+	// Revision is the ISO 3166 revision the code was validated against (see WithStandardRevision).
+	Revision string `json:"revision,omitempty"`
+
+	// SupportedMethods lists the payment methods supported by the country
+	// (ValidateCountryForPayment only).
+	SupportedMethods []string `json:"supported_methods,omitempty"`
+
+	// Restrictions lists known restrictions on shipping to the country, e.g.
+	// "customs declaration required" (ValidateCountryForShipping only).
+	Restrictions []string `json:"restrictions,omitempty"`
+
+	// CompanyName is the registered company name (ValidateVAT with WithVATLookup only).
+	CompanyName string `json:"company_name,omitempty"`
+
+	// Candidates lists the codes of the countries an ambiguous name could
+	// refer to (ResolveCountry only).
+	Candidates []string `json:"candidates,omitempty"`
+
+	// MatchKind reports whether a valid code matched exactly or through its
+	// parent (AllowParentSelection) or a related code (FollowRelated/FollowUpward).
+	// It is empty when the API doesn't report it.
+	MatchKind MatchKind `json:"match_kind,omitempty"`
+
+	// Ancestors lists the parent chain of a subdivision from its immediate
+	// parent up to the country, e.g. ["ES-M", "ES"] for "ES-MD"
+	// (SubdivisionOptions.IncludeAncestors only).
+	Ancestors []string `json:"ancestors,omitempty"`
+
+	// Level is the administrative level of a subdivision, e.g. LevelState, if
+	// the API reports it.
+	Level SubdivisionLevel `json:"level,omitempty"`
+
+	// Continent is the continent of the country, if the API reports it.
+	Continent Continent `json:"continent,omitempty"`
+
+	// Deprecated reports that the code is still accepted but officially
+	// deprecated, e.g. a transitional reservation; Valid stays true.
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// ReplacedBy is the code that replaces a deprecated code, if any.
+	ReplacedBy string `json:"replaced_by,omitempty"`
 }
 
+// MatchKind describes how a valid code matched.
+type MatchKind string
+
+const (
+	MatchExact   MatchKind = "exact"   // the code itself is valid
+	MatchParent  MatchKind = "parent"  // the code was accepted as the parent of a valid selection
+	MatchRelated MatchKind = "related" // the code was accepted through a related code
+)
+
 // CountryOptions toggles follow_upward logic.
 type CountryOptions struct {
 	FollowUpward bool
+
+	// RequireContinent, if set, reports otherwise valid countries as invalid
+	// unless their primary continent (see ContinentOf) is RequireContinent.
+	RequireContinent Continent
 }
 
 // SubdivisionOptions toggles follow_related / allow_parent_selection logic.
 type SubdivisionOptions struct {
 	FollowRelated        bool
 	AllowParentSelection bool
-}
 
-type multiResult struct {
-	Results []ValidationResult `json:"results"`
+	// IncludeAncestors asks the API to fill ValidationResult.Ancestors with the
+	// subdivision's parent chain. Results leave Ancestors empty if the API
+	// doesn't provide it.
+	IncludeAncestors bool
 }
 
 type apiError struct {
 	Message string `json:"message"`
 }
-
-
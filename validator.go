@@ -6,18 +6,60 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
 )
 
-const defaultBaseURL = "https://api.countriesdb.com"
+const (
+	defaultBaseURL             = "https://api.countriesdb.com"
+	defaultMaxResponseBodySize = 10 << 20
+)
 
 // Validator validates country and subdivision codes via the CountriesDB backend API.
 type Validator struct {
 	apiKey     string
 	baseURL    string
 	httpClient *http.Client
+	debug      io.Writer
+	requestLog io.Writer
+
+	postalValidators map[string]PostalValidator
+	revision         string
+	vatLookup        bool
+	ibanLookup       bool
+
+	maxResponseBodySize int64
+	maxRetries          int
+	backoff             BackoffStrategy
+	autoIdempotencyKey  bool
+	concurrency         int
+	contextHeaders      []contextHeader
+	language            string
+	strictDecoding      bool
+	timeout             time.Duration
+	cache               *responseCache
+	contextAPIKey       bool
+	batchSize           int
+	transportConfig     *TransportConfig
+	baseCtx             context.Context
+	audit               AuditSink
+
+	retryableStatusCodes map[int]bool
+	httpTrace            func(TimingInfo)
+	dryRun               *dryRunConfig
+	progress             func(done, total int)
+	policy               *codePolicy
+	warmup               bool
+	warmupCodes          []string
+	warmupDone           <-chan struct{}
+	rateLimit            *rateLimitState
+	failOnInvalid        bool
+	etags                *responseCache
+	apiKeyCheck          APIKeyCheck
+	authHeader           string
+	authFormat           string
 }
 
 // Option customizes the Validator.
@@ -41,144 +83,664 @@ func WithHTTPClient(h *http.Client) Option {
 	}
 }
 
+// WithTimeout sets the timeout of each HTTP request (10s by default). It also
+// applies to a client supplied with WithHTTPClient, which is copied rather than modified.
+func WithTimeout(d time.Duration) Option {
+	return func(v *Validator) {
+		if d > 0 {
+			v.timeout = d
+		}
+	}
+}
+
+// applyClientOptions applies WithTimeout and WithTransportConfig to a copy of
+// the http.Client, leaving clients supplied by the caller untouched.
+func (v *Validator) applyClientOptions() {
+	if v.timeout > 0 && v.httpClient.Timeout != v.timeout {
+		client := *v.httpClient
+		client.Timeout = v.timeout
+		v.httpClient = &client
+	}
+
+	if v.transportConfig != nil {
+		client := *v.httpClient
+		client.Transport = newTransport(*v.transportConfig)
+		v.httpClient = &client
+		v.transportConfig = nil
+	}
+}
+
+// WithStandardRevision pins validation to the ISO 3166 revision in effect on date
+// (formatted as YYYY-MM-DD). Results report the revision used in ValidationResult.Revision.
+func WithStandardRevision(date string) Option {
+	return func(v *Validator) {
+		v.revision = strings.TrimSpace(date)
+	}
+}
+
+// WithMaxResponseBodySize caps the size of API response bodies. Larger responses
+// fail with ErrResponseTooLarge instead of being read into memory. Defaults to 10 MB.
+func WithMaxResponseBodySize(bytes int64) Option {
+	return func(v *Validator) {
+		if bytes > 0 {
+			v.maxResponseBodySize = bytes
+		}
+	}
+}
+
+// WithLanguage requests localized names (e.g. "de" or "pt-BR") in ValidationResult.Name.
+// The API falls back to English when the language isn't available.
+func WithLanguage(tag string) Option {
+	return func(v *Validator) {
+		v.language = strings.TrimSpace(tag)
+	}
+}
+
+// WithStrictDecoding makes responses containing fields this package doesn't know
+// about fail to decode. It is meant for tests that should catch API contract drift.
+func WithStrictDecoding() Option {
+	return func(v *Validator) {
+		v.strictDecoding = true
+	}
+}
+
+// WithContextHeader copies the value stored in each call's context under ctxKey
+// into the headerName request header, e.g. to propagate a correlation ID. Calls
+// whose context has no value for ctxKey are sent without the header.
+func WithContextHeader(ctxKey any, headerName string) Option {
+	return func(v *Validator) {
+		if ctxKey != nil && headerName != "" {
+			v.contextHeaders = append(v.contextHeaders, contextHeader{key: ctxKey, name: headerName})
+		}
+	}
+}
+
+type contextHeader struct {
+	key  any
+	name string
+}
+
 // NewValidator creates a CountriesDB validator.
 func NewValidator(apiKey string, opts ...Option) (*Validator, error) {
 	if strings.TrimSpace(apiKey) == "" {
-		return nil, errors.New("countriesdb: api key is required")
+		return nil, ErrMissingAPIKey
 	}
 
 	validator := &Validator{
 		apiKey:  apiKey,
 		baseURL: defaultBaseURL,
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: newTransport(TransportConfig{}),
 		},
+		maxResponseBodySize: defaultMaxResponseBodySize,
+		backoff:             defaultBackoff,
+		concurrency:         defaultConcurrency,
+		batchSize:           defaultBatchSize,
+		authHeader:          defaultAuthHeader,
+		authFormat:          defaultAuthFormat,
+		rateLimit:           &rateLimitState{},
 	}
 
 	for _, opt := range opts {
 		opt(validator)
 	}
+	validator.applyClientOptions()
+
+	if err := validator.checkConfig(); err != nil {
+		return nil, err
+	}
+
+	validator.startWarmup()
 
 	return validator, nil
 }
 
-// ValidateCountry validates a single country code.
-func (v *Validator) ValidateCountry(ctx context.Context, code string, opts CountryOptions) (ValidationResult, error) {
-	if len(code) != 2 {
-		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+// checkConfig reports configuration errors that options can't return themselves.
+func (v *Validator) checkConfig() error {
+	if err := checkAPIKey(v.apiKey, v.apiKeyCheck); err != nil {
+		return err
+	}
+
+	if v.revision != "" {
+		if _, err := time.Parse(time.DateOnly, v.revision); err != nil {
+			return fmt.Errorf("countriesdb: invalid standard revision %q: must be YYYY-MM-DD", v.revision)
+		}
+	}
+
+	if v.authHeader == "" || strings.Count(v.authFormat, "%s") != 1 {
+		return fmt.Errorf("countriesdb: invalid auth header %q: %q must contain %%s exactly once", v.authHeader, v.authFormat)
+	}
+
+	return nil
+}
+
+// Clone returns a copy of v with opts applied on top of its configuration.
+// The clone shares v's http.Client (and therefore its connection pool) and its
+// response cache unless an option such as WithHTTPClient, WithTimeout,
+// WithTransportConfig or WithCache replaces them. It returns an error if the
+// resulting configuration is invalid, like NewValidator. Options that only act
+// at construction, such as WithWarmup, have no effect.
+func (v *Validator) Clone(opts ...Option) (*Validator, error) {
+	clone := *v
+
+	if v.postalValidators != nil {
+		clone.postalValidators = make(map[string]PostalValidator, len(v.postalValidators))
+		for country, pv := range v.postalValidators {
+			clone.postalValidators[country] = pv
+		}
+	}
+
+	clone.contextHeaders = append([]contextHeader(nil), v.contextHeaders...)
+	clone.rateLimit = &rateLimitState{}
+
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	clone.applyClientOptions()
+
+	if err := clone.checkConfig(); err != nil {
+		return nil, err
+	}
+
+	return &clone, nil
+}
+
+// BaseURL returns the API base URL the Validator sends requests to, without a
+// trailing slash. The API key is only available masked, via APIKeyMasked.
+func (v *Validator) BaseURL() string {
+	return v.baseURL
+}
+
+// ValidateCountry validates a single country code. Surrounding whitespace is
+// trimmed and the code is uppercased. Codes that are not two ASCII letters fail with an error wrapping ErrInvalidFormat
+// without calling the API.
+func (v *Validator) ValidateCountry(ctx context.Context, code string, opts CountryOptions, callOpts ...CallOption) (ValidationResult, error) {
+	result, err := v.validateCountry(WithCallOptions(ctx, callOpts...), code, opts)
+	if err != nil {
+		return result, err
+	}
+
+	return result, v.invalidError(code, result)
+}
+
+// validateCountry is ValidateCountry without WithFailOnInvalid.
+func (v *Validator) validateCountry(ctx context.Context, code string, opts CountryOptions) (ValidationResult, error) {
+	code = strings.TrimSpace(code)
+	if !IsValidCountryCodeFormat(code) {
+		return ValidationResult{}, fmt.Errorf("%w: country code %q must be two ASCII letters", ErrInvalidFormat, code)
+	}
+
+	upper, _ := asciiUpper(code)
+	if result, ok := v.countryPolicy(upper); ok {
+		return requireContinent(result, opts), nil
 	}
 
 	var result ValidationResult
 	err := v.post(ctx, "/api/validate/country", map[string]any{
-		"code":          strings.ToUpper(code),
+		"code":          upper,
 		"follow_upward": opts.FollowUpward,
 	}, &result)
+	if result.Code == "" {
+		result.Code = upper
+	}
+	if err != nil {
+		return result, err
+	}
 
-	return result, err
+	return requireContinent(result, opts), nil
 }
 
-// ValidateCountries validates multiple country codes.
-func (v *Validator) ValidateCountries(ctx context.Context, codes []string, opts CountryOptions) ([]ValidationResult, error) {
+// ValidateAndNormalizeCountry validates code like ValidateCountry and returns its
+// canonical form (ValidationResult.Code), e.g. before storing it. If the code is
+// invalid, it returns an error wrapping ErrInvalidCode with the API's message.
+func (v *Validator) ValidateAndNormalizeCountry(ctx context.Context, code string, opts CountryOptions) (string, error) {
+	result, err := v.ValidateCountry(ctx, code, opts)
+	if err != nil {
+		return "", err
+	}
+	if !result.Valid {
+		return "", fmt.Errorf("%w %q: %s", ErrInvalidCode, code, result.Message)
+	}
+
+	return result.Code, nil
+}
+
+// ValidateCountries validates multiple country codes. Codes are sent in chunks of
+// the configured batch size (see WithBatchSize), run concurrently up to the limit
+// set by WithConcurrency, and the results are returned in input order.
+func (v *Validator) ValidateCountries(ctx context.Context, codes []string, opts CountryOptions, callOpts ...CallOption) ([]ValidationResult, error) {
 	if len(codes) == 0 {
 		return []ValidationResult{}, nil
 	}
 
+	ctx = v.withProgress(WithCallOptions(ctx, callOpts...), len(codes))
+	results, err := v.validateCountriesChunked(ctx, codes, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, v.invalidErrors(codes, results)
+}
+
+// ValidateCountriesMap validates multiple country codes like ValidateCountries
+// and returns the results keyed by the trimmed, uppercased input code, e.g.
+// results["US"].Valid. Duplicate inputs collapse to a single entry.
+func (v *Validator) ValidateCountriesMap(ctx context.Context, codes []string, opts CountryOptions) (map[string]ValidationResult, error) {
+	results, err := v.ValidateCountries(ctx, codes, opts)
+	if results == nil {
+		return nil, err
+	}
+
+	byCode := make(map[string]ValidationResult, len(results))
+	for i, result := range results {
+		key, ok := normalizeCountryCode(codes[i])
+		if !ok {
+			key = codes[i]
+		}
+		byCode[key] = result
+	}
+
+	return byCode, err
+}
+
+// ValidateCountriesAllOrNothing validates multiple country codes and returns nil
+// only if every code is valid. Otherwise it returns an error wrapping ErrInvalidCode
+// that names the first invalid code and its message.
+func (v *Validator) ValidateCountriesAllOrNothing(ctx context.Context, codes []string, opts CountryOptions) error {
+	results, err := v.ValidateCountries(ctx, codes, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(results) != len(codes) {
+		return fmt.Errorf("countriesdb: got %d results for %d codes", len(results), len(codes))
+	}
+
+	for i, result := range results {
+		if !result.Valid {
+			return fmt.Errorf("%w %q: %s", ErrInvalidCode, codes[i], result.Message)
+		}
+	}
+
+	return nil
+}
+
+// countriesPayload normalizes codes for a multi-select request. Codes containing
+// non-ASCII characters or covered by WithCodePolicy are resolved locally and left
+// out of the payload.
+func (v *Validator) countriesPayload(codes []string) (map[string]any, map[int]ValidationResult) {
 	// Convert to uppercase - format validation handled by backend
-	upperCodes := make([]string, len(codes))
+	upperCodes := make([]string, 0, len(codes))
+	local := make(map[int]ValidationResult)
 	for i, code := range codes {
-		upperCodes[i] = strings.ToUpper(code)
+		upper, ok := normalizeCountryCode(code)
+		if !ok {
+			local[i] = ValidationResult{Valid: false, Message: nonASCIICountryMessage, Code: code}
+			continue
+		}
+		if result, ok := v.countryPolicy(upper); ok {
+			local[i] = result
+			continue
+		}
+		upperCodes = append(upperCodes, upper)
 	}
 
-	var response multiResult
-	err := v.post(ctx, "/api/validate/country", map[string]any{
+	return map[string]any{
 		"code":          upperCodes,
 		"follow_upward": false, // Disabled for multi-select
-	}, &response)
-
-	return response.Results, err
+	}, local
 }
 
 // ValidateSubdivision validates a single subdivision for a given country.
-func (v *Validator) ValidateSubdivision(ctx context.Context, code string, country string, opts SubdivisionOptions) (ValidationResult, error) {
-	if len(country) != 2 {
+func (v *Validator) ValidateSubdivision(ctx context.Context, code string, country string, opts SubdivisionOptions, callOpts ...CallOption) (ValidationResult, error) {
+	result, err := v.validateSubdivision(WithCallOptions(ctx, callOpts...), code, country, opts)
+	if err != nil {
+		return result, err
+	}
+
+	return result, v.invalidError(code, result)
+}
+
+// validateSubdivision is ValidateSubdivision without WithFailOnInvalid.
+func (v *Validator) validateSubdivision(ctx context.Context, code string, country string, opts SubdivisionOptions) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
 		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
 	}
 
-	var result ValidationResult
-	err := v.post(ctx, "/api/validate/subdivision", map[string]any{
-		"code":                   code,
-		"country":                strings.ToUpper(country),
+	normalized, ok := normalizeSubdivisionCode(code)
+	if !ok {
+		return ValidationResult{Valid: false, Message: nonASCIISubdivisionMessage}, nil
+	}
+	if result, ok := v.subdivisionPolicy(normalized, country); ok {
+		return result, nil
+	}
+
+	payload := map[string]any{
+		"code":                   normalized,
+		"country":                country,
 		"follow_related":         opts.FollowRelated,
 		"allow_parent_selection": opts.AllowParentSelection,
-	}, &result)
+	}
+	if opts.IncludeAncestors {
+		payload["include_ancestors"] = true
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/subdivision", payload, &result)
+	if result.Code == "" {
+		result.Code = normalized
+	}
 
 	return result, err
 }
 
 // ValidateSubdivisions validates multiple subdivisions for the same country.
-func (v *Validator) ValidateSubdivisions(ctx context.Context, codes []string, country string, opts SubdivisionOptions) ([]ValidationResult, error) {
+// Codes are sent as multi-select requests in chunks of the configured batch size
+// (see WithBatchSize), run concurrently up to the limit set by WithConcurrency,
+// and the results are returned in input order. With opts.FollowRelated, the API
+// only follows related subdivisions for single codes, so each code is then sent
+// on its own.
+func (v *Validator) ValidateSubdivisions(ctx context.Context, codes []string, country string, opts SubdivisionOptions, callOpts ...CallOption) ([]ValidationResult, error) {
 	if len(codes) == 0 {
 		return []ValidationResult{}, nil
 	}
 
+	ctx = v.withProgress(WithCallOptions(ctx, callOpts...), len(codes))
+	results, err := v.validateSubdivisions(ctx, codes, country, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, v.invalidErrors(codes, results)
+}
+
+// validateSubdivisions is ValidateSubdivisions without WithFailOnInvalid.
+func (v *Validator) validateSubdivisions(ctx context.Context, codes []string, country string, opts SubdivisionOptions) ([]ValidationResult, error) {
+	results := make([]ValidationResult, len(codes))
+	chunks := (len(codes) + v.batchSize - 1) / v.batchSize
+
+	err := runConcurrent(ctx, v.concurrency, chunks, func(ctx context.Context, j int) error {
+		start := j * v.batchSize
+		end := min(start+v.batchSize, len(codes))
+
+		return v.retryChunk(ctx, func() error {
+			next := start
+			return v.StreamSubdivisions(ctx, codes[start:end], country, opts, func(result ValidationResult) error {
+				results[next] = result
+				next++
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ValidateSubdivisionsMap validates multiple subdivisions like ValidateSubdivisions
+// and returns the results keyed by the trimmed, uppercased input code, e.g.
+// results["US-CA"].Valid. Duplicate inputs collapse to a single entry.
+func (v *Validator) ValidateSubdivisionsMap(ctx context.Context, codes []string, country string, opts SubdivisionOptions) (map[string]ValidationResult, error) {
+	results, err := v.ValidateSubdivisions(ctx, codes, country, opts)
+	if results == nil {
+		return nil, err
+	}
+
+	byCode := make(map[string]ValidationResult, len(results))
+	for i, result := range results {
+		key, ok := normalizeSubdivisionCode(codes[i])
+		if !ok {
+			key = codes[i]
+		}
+		byCode[key] = result
+	}
+
+	return byCode, err
+}
+
+// subdivisionsPayload normalizes codes for a multi-select request. Codes containing
+// non-ASCII characters or covered by WithCodePolicy are resolved locally and left
+// out of the payload.
+func (v *Validator) subdivisionsPayload(codes []string, country string, opts SubdivisionOptions) (map[string]any, map[int]ValidationResult, error) {
 	// Basic type check for country - format validation handled by backend
 	if country == "" {
-		return nil, errors.New("country must be a non-empty string")
+		return nil, nil, errors.New("country must be a non-empty string")
+	}
+
+	upperCountry, ok := asciiUpper(country)
+	if !ok {
+		return nil, nil, errors.New("country must contain only ASCII characters")
 	}
 
-	payloadCodes := make([]string, len(codes))
+	payloadCodes := make([]string, 0, len(codes))
+	local := make(map[int]ValidationResult)
 	for i, code := range codes {
-		if code == "" {
-			payloadCodes[i] = ""
+		normalized, ok := normalizeSubdivisionCode(code)
+		if !ok {
+			local[i] = ValidationResult{Valid: false, Message: nonASCIISubdivisionMessage, Code: code}
+			continue
+		}
+		if result, ok := v.subdivisionPolicy(normalized, upperCountry); ok {
+			local[i] = result
 			continue
 		}
-		payloadCodes[i] = code
+		payloadCodes = append(payloadCodes, normalized)
 	}
 
-	var response multiResult
-	err := v.post(ctx, "/api/validate/subdivision", map[string]any{
+	payload := map[string]any{
 		"code":                   payloadCodes,
-		"country":                strings.ToUpper(country),
-		"follow_related":         false, // Disabled for multi-select
+		"country":                upperCountry,
+		"follow_related":         false, // Multi-select can't follow related; see streamSubdivisionsSingly
 		"allow_parent_selection": opts.AllowParentSelection,
-	}, &response)
+	}
+	if opts.IncludeAncestors {
+		payload["include_ancestors"] = true
+	}
 
-	return response.Results, err
+	return payload, local, nil
 }
 
-func (v *Validator) post(ctx context.Context, path string, payload map[string]any, out any) error {
+func (v *Validator) post(ctx context.Context, path string, payload map[string]any, out any) (err error) {
+	if v.audit != nil {
+		var finish func(error) error
+		out, finish = v.startAudit(path, payload, out)
+		defer func() { err = finish(err) }()
+	}
+
+	if v.revision != "" {
+		payload["revision"] = v.revision
+	}
+
+	if v.dryRun != nil {
+		return v.postDryRun(path, payload, out)
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.baseURL+path, bytes.NewReader(body))
-	if err != nil {
-		return err
+	var cacheKey string
+	if v.cache != nil || v.etags != nil {
+		cacheKey = v.cacheKey(v.apiKeyFor(ctx), path, body)
+	}
+	if v.cache != nil {
+		if data, ok := v.cache.get(cacheKey); ok {
+			return v.decodeResponse(path, bytes.NewReader(data), out)
+		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+v.apiKey)
+	header := v.callHeader(ctx, path, body)
+	var tagged []byte
+	if v.etags != nil {
+		if data, etag, ok := v.etags.getTagged(cacheKey); ok {
+			tagged = data
+			header.Set("If-None-Match", etag)
+		}
+	}
 
-	resp, err := v.httpClient.Do(req)
+	ctx, stop := v.withBaseContext(ctx)
+	defer stop()
+	ctx, cancel := withCallTimeout(ctx)
+	defer cancel()
+
+	resp, err := v.do(ctx, path, body, header)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
+	var respBody io.Reader = &limitedReader{r: resp.Body, remaining: v.maxResponseBodySize}
+	if v.debug != nil || v.requestLog != nil {
+		data, err := io.ReadAll(respBody)
+		if err != nil {
+			return err
+		}
+		if v.debug != nil {
+			v.dumpResponse(resp, data)
+		}
+		if v.requestLog != nil {
+			v.logResponse(path, resp, data)
+		}
+		respBody = bytes.NewReader(data)
+	}
+
 	if resp.StatusCode >= 400 {
-		var apiErr apiError
-		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil || apiErr.Message == "" {
-			return fmt.Errorf("countriesdb: http %d", resp.StatusCode)
+		return newAPIError(resp, respBody)
+	}
+
+	if resp.StatusCode == http.StatusNotModified && tagged != nil {
+		respBody = bytes.NewReader(tagged)
+	}
+
+	if v.cache != nil || v.etags != nil {
+		data, err := io.ReadAll(respBody)
+		if err != nil {
+			return err
+		}
+		if v.cache != nil {
+			v.cache.add(cacheKey, data)
 		}
-		return errors.New(apiErr.Message)
+		if etag := resp.Header.Get("ETag"); v.etags != nil && etag != "" {
+			v.etags.addTagged(cacheKey, data, etag)
+		}
+		respBody = bytes.NewReader(data)
 	}
 
+	return v.decodeResponse(path, respBody, out)
+}
+
+// decodeResponse decodes a successful response body from path into out,
+// wrapping decoding failures with the path and a snippet of the body.
+func (v *Validator) decodeResponse(path string, r io.Reader, out any) error {
 	if out == nil {
 		return nil
 	}
 
-	return json.NewDecoder(resp.Body).Decode(out)
+	captured := &snippetWriter{limit: errorSnippetLimit}
+	if err := v.decode(io.TeeReader(r, captured), out); err != nil {
+		var sinkErr sinkError
+		switch {
+		case errors.As(err, &sinkErr):
+			return sinkErr.err
+		case errors.Is(err, ErrResponseTooLarge):
+			return err
+		default:
+			return fmt.Errorf("countriesdb: decoding %s response: %w (body: %s)", path, err, snippet(captured.buf, errorSnippetLimit))
+		}
+	}
+
+	return nil
 }
 
+// decode decodes a successful response body into out, or streams it to out if
+// out is a resultSink. With WithStrictDecoding, unknown fields are errors.
+func (v *Validator) decode(r io.Reader, out any) error {
+	if sink, ok := out.(resultSink); ok {
+		return streamResults(r, sink, v.strictDecoding)
+	}
+
+	dec := json.NewDecoder(r)
+	if v.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	if result, ok := out.(*ValidationResult); ok {
+		decoded, err := decodeResult(dec)
+		if err != nil {
+			return err
+		}
+		*result = decoded
+		return nil
+	}
+	return dec.Decode(out)
+}
+
+// callHeader returns the headers shared by every attempt of one request of body
+// to path, including a fresh X-Request-Id that a context header (see
+// WithContextHeader) may replace.
+func (v *Validator) callHeader(ctx context.Context, path string, body []byte) http.Header {
+	header := make(http.Header)
+	header.Set(requestIDHeader, newUUID())
+
+	if v.language != "" {
+		header.Set("Accept-Language", v.language)
+	}
 
+	if key := v.idempotencyKey(ctx, path, body); key != "" {
+		header.Set("Idempotency-Key", key)
+	}
+
+	for _, h := range v.contextHeaders {
+		if value := ctx.Value(h.key); value != nil {
+			header.Set(h.name, fmt.Sprint(value))
+		}
+	}
+
+	return header
+}
+
+// send makes a single POST request of body to path.
+func (v *Validator) send(ctx context.Context, path string, body []byte, header http.Header) (*http.Response, error) {
+	var trace *requestTrace
+	if v.httpTrace != nil {
+		ctx, trace = v.startTrace(ctx, path)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(v.authHeader, v.authValue(v.apiKeyFor(ctx)))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	if v.debug != nil {
+		v.dumpRequest(req, body)
+	}
+	if v.requestLog != nil {
+		v.logRequest(path, body)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		recordAttempt(ctx, 0, req.Header.Get(requestIDHeader))
+		if trace != nil {
+			trace.finish(err)
+		}
+		return nil, err
+	}
+
+	recordAttempt(ctx, resp.StatusCode, responseRequestID(resp))
+	v.recordRateLimit(resp.Header)
+	if trace != nil {
+		resp.Body = &tracedBody{ReadCloser: resp.Body, trace: trace}
+	}
+	return resp, nil
+}
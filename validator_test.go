@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// recordCodes starts a server that records the "code" field of each request and
+// answers every code as valid.
+func recordCodes(t *testing.T) (*Validator, *[]any) {
+	t.Helper()
+
+	var codes []any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Code any `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		codes = append(codes, payload.Code)
+
+		if batch, ok := payload.Code.([]any); ok {
+			results := make([]ValidationResult, len(batch))
+			for i := range batch {
+				results[i] = ValidationResult{Valid: true}
+			}
+			json.NewEncoder(w).Encode(map[string]any{"results": results})
+			return
+		}
+		json.NewEncoder(w).Encode(ValidationResult{Valid: true})
+	}))
+	t.Cleanup(srv.Close)
+
+	v, err := NewValidator("test-api-key", WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v, &codes
+}
+
+func TestValidateSubdivisionNormalizesCode(t *testing.T) {
+	v, codes := recordCodes(t)
+
+	for _, code := range []string{" us-ca ", "Us-Ca", "US-CA"} {
+		if _, err := v.ValidateSubdivision(context.Background(), code, "us", SubdivisionOptions{}); err != nil {
+			t.Fatalf("ValidateSubdivision(%q): %v", code, err)
+		}
+	}
+
+	want := []any{"US-CA", "US-CA", "US-CA"}
+	if !reflect.DeepEqual(*codes, want) {
+		t.Errorf("sent codes %v, want %v", *codes, want)
+	}
+}
+
+func TestValidateSubdivisionsNormalizesCodes(t *testing.T) {
+	v, codes := recordCodes(t)
+
+	if _, err := v.ValidateSubdivisions(context.Background(), []string{" us-ca ", "Us-Ny", "TX"}, "US", SubdivisionOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []any{[]any{"US-CA", "US-NY", "TX"}}
+	if !reflect.DeepEqual(*codes, want) {
+		t.Errorf("sent codes %v, want %v", *codes, want)
+	}
+}
+
+func TestValidateCountryNormalizesCode(t *testing.T) {
+	v, codes := recordCodes(t)
+
+	if _, err := v.ValidateCountry(context.Background(), " us ", CountryOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.ValidateCountries(context.Background(), []string{" ca ", "Mx"}, CountryOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []any{"US", []any{"CA", "MX"}}
+	if !reflect.DeepEqual(*codes, want) {
+		t.Errorf("sent codes %v, want %v", *codes, want)
+	}
+}
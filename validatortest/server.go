@@ -0,0 +1,144 @@
+// Package validatortest provides a fake CountriesDB API for testing code that
+// uses the validator package.
+package validatortest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	validator "github.com/countriesdb/validator-go"
+)
+
+// APIKey is the API key of validators created by the Server.
+const APIKey = "validatortest-key"
+
+// Response is a scripted reply of the fake server.
+type Response struct {
+	// StatusCode defaults to 200.
+	StatusCode int
+	Header     http.Header
+	// Body is encoded as JSON.
+	Body any
+}
+
+// Result returns a response for a single-code validation call.
+func Result(result validator.ValidationResult) Response {
+	return Response{Body: result}
+}
+
+// Results returns a response for a multi-code validation call.
+func Results(results ...validator.ValidationResult) Response {
+	if results == nil {
+		results = []validator.ValidationResult{}
+	}
+	return Response{Body: map[string]any{"results": results}}
+}
+
+// Error returns an error response with the API's JSON error body.
+func Error(statusCode int, message string) Response {
+	return Response{StatusCode: statusCode, Body: map[string]any{"message": message}}
+}
+
+// RateLimited returns a 429 response with a Retry-After header. The header
+// carries whole seconds, so retryAfter is rounded up, to at least 1 second.
+func RateLimited(retryAfter time.Duration) Response {
+	seconds := max(int((retryAfter+time.Second-1)/time.Second), 1)
+	header := make(http.Header)
+	header.Set("Retry-After", strconv.Itoa(seconds))
+	return Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     header,
+		Body:       map[string]any{"message": "Too many requests."},
+	}
+}
+
+// Request is a request received by the fake server.
+type Request struct {
+	Path    string
+	Header  http.Header
+	Payload map[string]any
+}
+
+// Server is a fake CountriesDB API that replies with scripted responses in order.
+// Once the script is exhausted it replies with a 500 error.
+type Server struct {
+	*httptest.Server
+
+	// Validator is a validator pointed at the server.
+	Validator *validator.Validator
+
+	mu        sync.Mutex
+	responses []Response
+	requests  []Request
+}
+
+// NewServer starts a fake CountriesDB API that replies with responses in order.
+// It is closed when tb and its subtests complete.
+func NewServer(tb testing.TB, responses ...Response) *Server {
+	tb.Helper()
+
+	s := &Server{responses: responses}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	tb.Cleanup(s.Close)
+	s.Validator = s.NewValidator(tb)
+	return s
+}
+
+// NewValidator returns a validator pointed at the server with opts applied. It
+// fails tb if the options are invalid.
+func (s *Server) NewValidator(tb testing.TB, opts ...validator.Option) *validator.Validator {
+	tb.Helper()
+
+	opts = append([]validator.Option{validator.WithBaseURL(s.URL)}, opts...)
+	v, err := validator.NewValidator(APIKey, opts...)
+	if err != nil {
+		tb.Fatal("validatortest: ", err)
+	}
+	return v
+}
+
+// Enqueue appends responses to the script.
+func (s *Server) Enqueue(responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, responses...)
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var payload map[string]any
+	json.NewDecoder(r.Body).Decode(&payload)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Path: r.URL.Path, Header: r.Header.Clone(), Payload: payload})
+	resp := Error(http.StatusInternalServerError, "validatortest: no scripted response")
+	if len(s.responses) > 0 {
+		resp = s.responses[0]
+		s.responses = s.responses[1:]
+	}
+	s.mu.Unlock()
+
+	for name, values := range resp.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	status := resp.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(resp.Body)
+}
@@ -0,0 +1,171 @@
+package validatortest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	validator "github.com/countriesdb/validator-go"
+	"github.com/countriesdb/validator-go/validatortest"
+)
+
+func TestResult(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Result(validator.ValidationResult{Valid: true, Code: "US", Name: "United States"}))
+
+	result, err := srv.Validator.ValidateCountry(context.Background(), "us", validator.CountryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid || result.Code != "US" || result.Name != "United States" {
+		t.Errorf("got %+v, want the scripted result", result)
+	}
+}
+
+func TestResults(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Results(
+		validator.ValidationResult{Valid: true, Code: "US"},
+		validator.ValidationResult{Valid: false, Code: "XX", Message: "Invalid country code."},
+	))
+
+	results, err := srv.Validator.ValidateCountries(context.Background(), []string{"US", "XX"}, validator.CountryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || !results[0].Valid || results[1].Valid || results[1].Message != "Invalid country code." {
+		t.Errorf("got %+v, want the scripted results", results)
+	}
+}
+
+func TestError(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Error(http.StatusUnauthorized, "Invalid API key."))
+
+	_, err := srv.Validator.ValidateCountry(context.Background(), "US", validator.CountryOptions{})
+	var apiErr *validator.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got %v, want an *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized || apiErr.Message != "Invalid API key." {
+		t.Errorf("got status %d and message %q, want the scripted error", apiErr.StatusCode, apiErr.Message)
+	}
+}
+
+func TestRateLimited(t *testing.T) {
+	for _, tc := range []struct {
+		retryAfter time.Duration
+		want       string
+	}{
+		{0, "1"},
+		{500 * time.Millisecond, "1"},
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{time.Minute, "60"},
+	} {
+		resp := validatortest.RateLimited(tc.retryAfter)
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("RateLimited(%v) has status %d, want 429", tc.retryAfter, resp.StatusCode)
+		}
+		if got := resp.Header.Get("Retry-After"); got != tc.want {
+			t.Errorf("RateLimited(%v) has Retry-After %q, want %q", tc.retryAfter, got, tc.want)
+		}
+	}
+
+	srv := validatortest.NewServer(t, validatortest.RateLimited(300*time.Millisecond))
+	_, err := srv.Validator.ValidateCountry(context.Background(), "US", validator.CountryOptions{})
+	var apiErr *validator.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests || apiErr.RetryAfter != time.Second {
+		t.Errorf("got %v, want a 429 *APIError asking to retry after 1s", err)
+	}
+}
+
+func TestCustomResponse(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"X-Request-Id": {"req-1"}},
+		Body:       map[string]any{"message": "Maintenance."},
+	})
+
+	_, err := srv.Validator.ValidateCountry(context.Background(), "US", validator.CountryOptions{})
+	var apiErr *validator.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.RequestID != "req-1" {
+		t.Errorf("got %v, want a 503 *APIError with request ID req-1", err)
+	}
+}
+
+func TestScriptOrderAndExhaustion(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Result(validator.ValidationResult{Valid: true, Code: "US"}))
+	srv.Enqueue(validatortest.Result(validator.ValidationResult{Valid: false, Code: "XX"}))
+
+	ctx := context.Background()
+	for _, want := range []bool{true, false} {
+		result, err := srv.Validator.ValidateCountry(ctx, "US", validator.CountryOptions{})
+		if err != nil || result.Valid != want {
+			t.Fatalf("got %+v, %v, want Valid %v", result, err, want)
+		}
+	}
+
+	_, err := srv.Validator.ValidateCountry(ctx, "US", validator.CountryOptions{})
+	var apiErr *validator.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got %v once the script is exhausted, want a 500 *APIError", err)
+	}
+}
+
+func TestRequests(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.Result(validator.ValidationResult{Valid: true, Code: "US-CA"}))
+
+	if _, err := srv.Validator.ValidateSubdivision(context.Background(), "us-ca", "us", validator.SubdivisionOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if req.Path != "/api/validate/subdivision" {
+		t.Errorf("got path %q, want /api/validate/subdivision", req.Path)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer "+validatortest.APIKey {
+		t.Errorf("got Authorization %q, want the test API key", got)
+	}
+	if req.Payload["code"] != "US-CA" || req.Payload["country"] != "US" {
+		t.Errorf("got payload %v, want code US-CA of US", req.Payload)
+	}
+}
+
+func TestNewValidatorOptions(t *testing.T) {
+	srv := validatortest.NewServer(t, validatortest.RateLimited(0), validatortest.Result(validator.ValidationResult{Valid: true}))
+
+	// The 1s Retry-After is waited out before the retry.
+	v := srv.NewValidator(t, validator.WithRetry(1))
+	result, err := v.ValidateCountry(context.Background(), "US", validator.CountryOptions{})
+	if err != nil || !result.Valid {
+		t.Fatalf("got %+v, %v, want the retried result", result, err)
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("got %d requests, want 2", n)
+	}
+}
+
+// fatalRecorder records Fatal calls instead of stopping the test.
+type fatalRecorder struct {
+	testing.TB
+	fatal string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatal(args ...any) { r.fatal = fmt.Sprint(args...) }
+
+func TestNewValidatorFailsTest(t *testing.T) {
+	srv := validatortest.NewServer(t)
+	rec := &fatalRecorder{TB: t}
+
+	srv.NewValidator(rec, validator.WithAuthHeader("X-Key", "no placeholder"))
+	if rec.fatal == "" {
+		t.Error("NewValidator with an invalid option didn't fail the test")
+	}
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// vatPatterns holds the format of VAT numbers per country, without the country prefix.
+var vatPatterns = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^U\d{8}$`),
+	"BE": regexp.MustCompile(`^[01]\d{9}$`),
+	"BG": regexp.MustCompile(`^\d{9,10}$`),
+	"CH": regexp.MustCompile(`^E\d{9}(MWST|TVA|IVA)?$`),
+	"CY": regexp.MustCompile(`^\d{8}[A-Z]$`),
+	"CZ": regexp.MustCompile(`^\d{8,10}$`),
+	"DE": regexp.MustCompile(`^\d{9}$`),
+	"DK": regexp.MustCompile(`^\d{8}$`),
+	"EE": regexp.MustCompile(`^\d{9}$`),
+	"ES": regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`),
+	"FI": regexp.MustCompile(`^\d{8}$`),
+	"FR": regexp.MustCompile(`^[A-HJ-NP-Z0-9]{2}\d{9}$`),
+	"GB": regexp.MustCompile(`^(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`),
+	"GR": regexp.MustCompile(`^\d{9}$`),
+	"HR": regexp.MustCompile(`^\d{11}$`),
+	"HU": regexp.MustCompile(`^\d{8}$`),
+	"IE": regexp.MustCompile(`^(\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`),
+	"IT": regexp.MustCompile(`^\d{11}$`),
+	"LT": regexp.MustCompile(`^(\d{9}|\d{12})$`),
+	"LU": regexp.MustCompile(`^\d{8}$`),
+	"LV": regexp.MustCompile(`^\d{11}$`),
+	"MT": regexp.MustCompile(`^\d{8}$`),
+	"NL": regexp.MustCompile(`^\d{9}B\d{2}$`),
+	"NO": regexp.MustCompile(`^\d{9}(MVA)?$`),
+	"PL": regexp.MustCompile(`^\d{10}$`),
+	"PT": regexp.MustCompile(`^\d{9}$`),
+	"RO": regexp.MustCompile(`^\d{2,10}$`),
+	"SE": regexp.MustCompile(`^\d{10}01$`),
+	"SI": regexp.MustCompile(`^\d{8}$`),
+	"SK": regexp.MustCompile(`^\d{10}$`),
+}
+
+// vatPrefixes holds VAT prefixes that differ from the ISO 3166-1 code.
+var vatPrefixes = map[string]string{
+	"GR": "EL",
+}
+
+// WithVATLookup makes ValidateVAT confirm format-valid VAT numbers against the
+// live registry through the CountriesDB API. Without it, ValidateVAT only checks
+// the format locally.
+func WithVATLookup() Option {
+	return func(v *Validator) {
+		v.vatLookup = true
+	}
+}
+
+// ValidateVAT validates the format of a VAT number (e.g. "DE123456789") for a country.
+// The country prefix is optional. With WithVATLookup, format-valid numbers are also
+// looked up in the live registry and the result's CompanyName is populated when known.
+func (v *Validator) ValidateVAT(ctx context.Context, vat string, country string) (ValidationResult, error) {
+	country, ok := countryParam(country)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid country code."}, nil
+	}
+
+	prefix := country
+	if p, ok := vatPrefixes[country]; ok {
+		prefix = p
+	}
+
+	number, ok := normalizeVAT(vat)
+	if !ok {
+		return ValidationResult{Valid: false, Message: "Invalid VAT number format."}, nil
+	}
+	number = strings.TrimPrefix(number, prefix)
+
+	pattern, known := vatPatterns[country]
+	if known && !pattern.MatchString(number) {
+		return ValidationResult{Valid: false, Message: "Invalid VAT number format."}, nil
+	}
+
+	if !v.vatLookup {
+		if !known {
+			return ValidationResult{Valid: false, Message: "VAT number format is not known for this country."}, nil
+		}
+		return ValidationResult{Valid: true, Code: prefix + number}, nil
+	}
+
+	var result ValidationResult
+	err := v.post(ctx, "/api/validate/vat", map[string]any{
+		"vat":     prefix + number,
+		"country": country,
+	}, &result)
+
+	return result, err
+}
+
+// normalizeVAT uppercases vat and strips spaces, dots and dashes.
+func normalizeVAT(vat string) (string, bool) {
+	vat = strings.NewReplacer(" ", "", ".", "", "-", "").Replace(strings.TrimSpace(vat))
+	upper, ok := asciiUpper(vat)
+	return upper, ok && upper != ""
+}
@@ -0,0 +1,38 @@
+package validator
+
+import "runtime/debug"
+
+const modulePath = "github.com/countriesdb/validator-go"
+
+// devVersion is reported by Version when the module version isn't known, e.g.
+// when built from a source checkout or without build info.
+const devVersion = "dev"
+
+// Version returns the version of this module the binary was built with (e.g.
+// "v1.4.0"), read from the build info, or "dev" when it isn't available.
+// Include it when reporting bugs.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return devVersion
+	}
+
+	version := info.Main.Version
+	if info.Main.Path != modulePath {
+		version = ""
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				if dep.Replace != nil {
+					dep = dep.Replace
+				}
+				version = dep.Version
+				break
+			}
+		}
+	}
+
+	if version == "" || version == "(devel)" {
+		return devVersion
+	}
+	return version
+}
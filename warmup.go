@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// WithWarmup makes NewValidator warm the Validator up in the background, so the
+// first calls don't pay for DNS lookups and TLS handshakes. Together with
+// WithCache, it validates codes with ValidateCountry, concurrently up to the
+// limit set by WithConcurrency, so that later ValidateCountry calls for them with
+// default options are answered from the cache. Otherwise, or without codes, it
+// calls Warmup. Failures are ignored. Use WarmupDone to wait for it to finish.
+func WithWarmup(codes []string) Option {
+	return func(v *Validator) {
+		v.warmup = true
+		v.warmupCodes = append([]string(nil), codes...)
+	}
+}
+
+// WarmupDone returns a channel that is closed once the warmup started by
+// WithWarmup has finished. Without a warmup it is already closed.
+func (v *Validator) WarmupDone() <-chan struct{} {
+	return v.warmupDone
+}
+
+// Warmup primes the connection pool by sending a HEAD request to the base URL,
+// without the API key, so that the next validation reuses the connection. Any
+// HTTP response counts as success; it returns an error only if the API can't be
+// reached.
+func (v *Validator) Warmup(ctx context.Context) error {
+	ctx, stop := v.withBaseContext(ctx)
+	defer stop()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, v.baseURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+// startWarmup starts the warmup configured by WithWarmup, if any.
+func (v *Validator) startWarmup() {
+	done := make(chan struct{})
+	v.warmupDone = done
+
+	if !v.warmup {
+		close(done)
+		return
+	}
+
+	codes := v.warmupCodes
+	go func() {
+		defer close(done)
+
+		ctx := context.Background()
+		if len(codes) == 0 || v.cache == nil {
+			v.Warmup(ctx)
+			return
+		}
+
+		runConcurrent(ctx, v.concurrency, len(codes), func(ctx context.Context, i int) error {
+			v.validateCountry(ctx, codes[i], CountryOptions{})
+			return nil
+		})
+	}()
+}